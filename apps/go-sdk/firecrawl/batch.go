@@ -0,0 +1,183 @@
+package firecrawl
+
+import (
+	"context"
+	"math"
+	"math/rand"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// RetryPolicy configures how failed requests are retried with exponential
+// backoff. FirecrawlApp applies it to every request it makes (see
+// ClientOptions.RetryPolicy), and ScrapeURLs can override it per batch via
+// BatchOptions.RetryPolicy.
+type RetryPolicy struct {
+	// MaxAttempts is the maximum number of attempts for a single request,
+	// including the first one. Zero or negative selects DefaultRetryPolicy.
+	MaxAttempts int
+	// InitialBackoff is the backoff before the first retry.
+	InitialBackoff time.Duration
+	// MaxBackoff caps the backoff between retries.
+	MaxBackoff time.Duration
+	// Multiplier scales the backoff after each attempt. Defaults to 2 when zero.
+	Multiplier float64
+	// RetryOnStatuses lists HTTP status codes that should be retried, in
+	// addition to network errors. Defaults to {429, 500, 502, 503, 504} when empty.
+	RetryOnStatuses []int
+}
+
+// DefaultRetryPolicy is a reasonable default used when ClientOptions.RetryPolicy is unset.
+var DefaultRetryPolicy = RetryPolicy{
+	MaxAttempts:     3,
+	InitialBackoff:  500 * time.Millisecond,
+	MaxBackoff:      8 * time.Second,
+	Multiplier:      2,
+	RetryOnStatuses: []int{http.StatusTooManyRequests, 500, 502, 503, 504},
+}
+
+func (p RetryPolicy) retryableStatuses() []int {
+	if len(p.RetryOnStatuses) > 0 {
+		return p.RetryOnStatuses
+	}
+	return DefaultRetryPolicy.RetryOnStatuses
+}
+
+func (p RetryPolicy) isRetryableStatus(statusCode int) bool {
+	for _, s := range p.retryableStatuses() {
+		if s == statusCode {
+			return true
+		}
+	}
+	return false
+}
+
+func (p RetryPolicy) backoff(attempt int) time.Duration {
+	multiplier := p.Multiplier
+	if multiplier == 0 {
+		multiplier = DefaultRetryPolicy.Multiplier
+	}
+	initial := p.InitialBackoff
+	if initial == 0 {
+		initial = DefaultRetryPolicy.InitialBackoff
+	}
+	maxBackoff := p.MaxBackoff
+	if maxBackoff == 0 {
+		maxBackoff = DefaultRetryPolicy.MaxBackoff
+	}
+
+	backoff := time.Duration(float64(initial) * math.Pow(multiplier, float64(attempt)))
+	if backoff > maxBackoff {
+		backoff = maxBackoff
+	}
+	// Full jitter: sleep for a random duration in [0, backoff].
+	return time.Duration(rand.Int63n(int64(backoff) + 1))
+}
+
+// BatchOptions configures ScrapeURLs.
+type BatchOptions struct {
+	// Concurrency is the number of URLs scraped in parallel. Defaults to 5 when zero or negative.
+	Concurrency int
+	// RetryPolicy overrides app.RetryPolicy for the duration of the batch.
+	// Defaults to app.RetryPolicy (and, transitively, DefaultRetryPolicy) when zero.
+	RetryPolicy RetryPolicy
+	// PerRequestTimeout, if positive, bounds how long a single URL's scrape (including retries) may take.
+	PerRequestTimeout time.Duration
+	// IdempotencyKey, if set, is sent with every request in the batch.
+	IdempotencyKey string
+}
+
+// BatchResult is the outcome of scraping a single URL as part of ScrapeURLs.
+type BatchResult struct {
+	URL string
+	Doc *FirecrawlDocument
+	Err error
+}
+
+// ScrapeURLs scrapes urls concurrently with bounded concurrency. Per-URL
+// retries on failure (429/5xx and network errors, with exponential backoff)
+// are handled by the underlying ScrapeURLContext call per opts.RetryPolicy.
+// Results are returned in the same order as urls, regardless of completion
+// order.
+//
+// Parameters:
+//   - ctx: Allows cancelling the whole batch; in-flight requests stop retrying once ctx is done.
+//   - urls: The URLs to scrape.
+//   - params: Optional parameters applied to every scrape request.
+//   - opts: Concurrency, retry, and per-request timeout settings.
+//
+// Returns:
+//   - []BatchResult: One result per URL, in input order, each carrying either a document or an error.
+//     If ctx finishes before every URL was handed to a worker, the entries for URLs never handed out are zero-valued.
+//   - error: ctx.Err() if ctx is done before work starts (with nil results) or before every URL was handed to a worker.
+func (app *FirecrawlApp) ScrapeURLs(ctx context.Context, urls []string, params map[string]any, opts BatchOptions) ([]BatchResult, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	concurrency := opts.Concurrency
+	if concurrency <= 0 {
+		concurrency = 5
+	}
+
+	// A shallow field copy, not *app, so FirecrawlApp's internal mutex is
+	// never copied.
+	batchApp := &FirecrawlApp{
+		APIKey:      app.APIKey,
+		APIURL:      app.APIURL,
+		Client:      app.Client,
+		Options:     app.Options,
+		RetryPolicy: app.RetryPolicy,
+	}
+	batchApp.SetConverter(app.getConverter())
+	if opts.RetryPolicy.MaxAttempts > 0 {
+		batchApp.RetryPolicy = opts.RetryPolicy
+	}
+
+	results := make([]BatchResult, len(urls))
+	jobs := make(chan int)
+
+	var wg sync.WaitGroup
+	for i := 0; i < concurrency; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for idx := range jobs {
+				results[idx] = batchApp.scrapeURLOnce(ctx, urls[idx], params, opts)
+			}
+		}()
+	}
+
+	for idx := range urls {
+		select {
+		case jobs <- idx:
+		case <-ctx.Done():
+			close(jobs)
+			wg.Wait()
+			return results, ctx.Err()
+		}
+	}
+	close(jobs)
+	wg.Wait()
+
+	return results, nil
+}
+
+// scrapeURLOnce scrapes a single URL, enforcing opts.PerRequestTimeout if
+// set. app.RetryPolicy (a copy held by ScrapeURLs, possibly overridden by
+// opts.RetryPolicy) governs retries within ScrapeURLContext itself.
+func (app *FirecrawlApp) scrapeURLOnce(ctx context.Context, url string, params map[string]any, opts BatchOptions) BatchResult {
+	requestCtx := ctx
+	if opts.PerRequestTimeout > 0 {
+		var cancel context.CancelFunc
+		requestCtx, cancel = context.WithTimeout(ctx, opts.PerRequestTimeout)
+		defer cancel()
+	}
+
+	doc, err := app.scrapeURLContext(requestCtx, url, params, opts.IdempotencyKey)
+	if err != nil {
+		return BatchResult{URL: url, Err: err}
+	}
+	return BatchResult{URL: url, Doc: doc}
+}
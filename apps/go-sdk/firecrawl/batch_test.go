@@ -0,0 +1,77 @@
+package firecrawl
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestScrapeURLsPreservesOrderAndReportsPerURLErrors(t *testing.T) {
+	app, err := NewFirecrawlApp(TEST_API_KEY, API_URL)
+	require.NoError(t, err)
+
+	urls := []string{
+		"https://roastmywebsite.ai",
+		"https://facebook.com/fake-test",
+		"https://firecrawl.dev",
+	}
+
+	results, err := app.ScrapeURLs(context.Background(), urls, nil, BatchOptions{Concurrency: 2})
+	require.NoError(t, err)
+	require.Len(t, results, len(urls))
+
+	for i, url := range urls {
+		assert.Equal(t, url, results[i].URL)
+	}
+	assert.NoError(t, results[0].Err)
+	assert.Error(t, results[1].Err)
+}
+
+func TestScrapeURLsRespectsContextCancellation(t *testing.T) {
+	app, err := NewFirecrawlApp(TEST_API_KEY, API_URL)
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err = app.ScrapeURLs(ctx, []string{"https://roastmywebsite.ai"}, nil, BatchOptions{})
+	assert.ErrorIs(t, err, context.Canceled)
+}
+
+func TestScrapeURLsSendsIdempotencyKeyHeader(t *testing.T) {
+	var mu sync.Mutex
+	var gotKeys []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		gotKeys = append(gotKeys, r.Header.Get("x-idempotency-key"))
+		mu.Unlock()
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"success": true, "data": {}}`))
+	}))
+	defer server.Close()
+
+	app, err := NewFirecrawlApp("test-key", server.URL)
+	require.NoError(t, err)
+
+	urls := []string{"https://a.test", "https://b.test"}
+	results, err := app.ScrapeURLs(context.Background(), urls, nil, BatchOptions{IdempotencyKey: "batch-key"})
+	require.NoError(t, err)
+	require.Len(t, results, len(urls))
+
+	assert.Equal(t, []string{"batch-key", "batch-key"}, gotKeys)
+}
+
+func TestRetryPolicyBackoffRespectsMaxBackoff(t *testing.T) {
+	policy := RetryPolicy{InitialBackoff: 1 * time.Second, MaxBackoff: 2 * time.Second, Multiplier: 10}
+
+	for attempt := 0; attempt < 5; attempt++ {
+		assert.LessOrEqual(t, policy.backoff(attempt), policy.MaxBackoff)
+	}
+}
+
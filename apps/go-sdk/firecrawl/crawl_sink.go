@@ -0,0 +1,79 @@
+package firecrawl
+
+import (
+	"context"
+	"time"
+)
+
+// CrawlURLToSink starts a crawl job for url and writes each document to
+// sink as it becomes available, instead of accumulating them in memory the
+// way CrawlURL(waitUntilDone=true) does. sink.Close is always called once
+// streaming ends, regardless of outcome.
+//
+// Parameters:
+//   - ctx: Controls cancellation of the crawl; the job is cancelled if ctx finishes first.
+//   - url: The URL to crawl.
+//   - params: Optional parameters for the crawl request.
+//   - idempotencyKey: An optional idempotency key to ensure the request is idempotent.
+//   - sink: Where each crawled document is persisted.
+//
+// Returns:
+//   - error: An error if starting the crawl, polling its status, or sink.Write/Close fails; nil once the crawl completes successfully.
+func (app *FirecrawlApp) CrawlURLToSink(ctx context.Context, url string, params map[string]any, idempotencyKey string, sink Sink) error {
+	err := app.CrawlURLStream(ctx, url, params, idempotencyKey, func(doc *FirecrawlDocument) error {
+		return sink.Write(ctx, doc)
+	})
+	if closeErr := sink.Close(); err == nil {
+		err = closeErr
+	}
+	return err
+}
+
+// SubscribeCrawlToSink wraps SubscribeCrawl, writing every delivered
+// document to sink in addition to forwarding it on the returned channel, so
+// callers can persist results while still observing progress and completion
+// events. Once the subscription has started, sink is closed when it ends,
+// regardless of outcome; if SubscribeCrawl returns an error, sink is left open.
+//
+// Parameters:
+//   - ctx: Controls cancellation of the subscription.
+//   - jobID: The ID of an already-started crawl job (see CrawlURL).
+//   - pollInterval: How often to poll the job status. A floor of 2 seconds is enforced.
+//   - sink: Where each crawled document is persisted.
+//
+// Returns:
+//   - <-chan CrawlEvent: The event stream; always closed exactly once, after its terminal event unless ctx is done first.
+//   - error: An error if ctx is already done.
+func (app *FirecrawlApp) SubscribeCrawlToSink(ctx context.Context, jobID string, pollInterval time.Duration, sink Sink) (<-chan CrawlEvent, error) {
+	// A child context, not ctx itself: if sink.Write fails we stop ranging
+	// over events before it reaches a terminal state, and SubscribeCrawl's
+	// producer goroutine only stops sending once its context is done.
+	subscribeCtx, cancel := context.WithCancel(ctx)
+
+	events, err := app.SubscribeCrawl(subscribeCtx, jobID, pollInterval)
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+
+	out := make(chan CrawlEvent)
+	go func() {
+		defer cancel()
+		defer close(out)
+		defer sink.Close()
+
+		for event := range events {
+			if event.Type == CrawlEventDocument {
+				if err := sink.Write(ctx, event.Document); err != nil {
+					sendCrawlEvent(ctx, out, CrawlEvent{Type: CrawlEventFailed, Err: err})
+					return
+				}
+			}
+			if !sendCrawlEvent(ctx, out, event) {
+				return
+			}
+		}
+	}()
+
+	return out, nil
+}
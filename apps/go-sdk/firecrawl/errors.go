@@ -0,0 +1,87 @@
+package firecrawl
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// Sentinel errors for the status codes the Firecrawl API is known to return.
+// Use errors.Is(err, firecrawl.ErrRateLimited) rather than comparing
+// APIError.StatusCode directly, so callers don't need to know which codes
+// the API happens to use today.
+var (
+	ErrUnauthorized    = fmt.Errorf("firecrawl: unauthorized")
+	ErrPaymentRequired = fmt.Errorf("firecrawl: payment required")
+	ErrTimeout         = fmt.Errorf("firecrawl: request timeout")
+	ErrConflict        = fmt.Errorf("firecrawl: conflict")
+	ErrRateLimited     = fmt.Errorf("firecrawl: rate limited")
+	ErrServer          = fmt.Errorf("firecrawl: server error")
+)
+
+// statusSentinels maps status codes the API is known to return to the
+// sentinel error callers should match against with errors.Is.
+var statusSentinels = map[int]error{
+	http.StatusUnauthorized:        ErrUnauthorized,
+	http.StatusPaymentRequired:     ErrPaymentRequired,
+	http.StatusRequestTimeout:      ErrTimeout,
+	http.StatusConflict:            ErrConflict,
+	http.StatusTooManyRequests:     ErrRateLimited,
+	http.StatusInternalServerError: ErrServer,
+}
+
+// APIError describes a non-2xx response from the Firecrawl API. It is
+// returned (wrapped in nothing, or wrapped by a caller) from any request
+// method, and can be matched with errors.As.
+type APIError struct {
+	// StatusCode is the HTTP status code the API responded with.
+	StatusCode int
+	// Action describes what the client was attempting to do, e.g. "scrape URL".
+	Action string
+	// Message is the error message reported by the API, if any.
+	Message string
+	// RawBody is the unparsed response body, for callers that need more than Message.
+	RawBody []byte
+	// RetryAfter is the duration parsed from the response's Retry-After
+	// header (in seconds), or zero if absent or malformed. It is set for
+	// any status code whose response carries the header.
+	RetryAfter time.Duration
+}
+
+// Error implements error.
+func (e *APIError) Error() string {
+	message := e.Message
+	if message == "" {
+		message = "no additional error details provided"
+	}
+
+	switch e.StatusCode {
+	case http.StatusUnauthorized:
+		return fmt.Sprintf("unauthorized: failed to %s: %s", e.Action, message)
+	case http.StatusPaymentRequired:
+		return fmt.Sprintf("payment required: failed to %s: %s", e.Action, message)
+	case http.StatusRequestTimeout:
+		return fmt.Sprintf("request timeout: failed to %s as the request timed out: %s", e.Action, message)
+	case http.StatusConflict:
+		return fmt.Sprintf("conflict: failed to %s due to a conflict: %s", e.Action, message)
+	case http.StatusTooManyRequests:
+		return fmt.Sprintf("rate limited: failed to %s: %s", e.Action, message)
+	case http.StatusInternalServerError:
+		return fmt.Sprintf("server error: failed to %s: %s", e.Action, message)
+	default:
+		return fmt.Sprintf("unexpected error during %s: status code %d: %s", e.Action, e.StatusCode, message)
+	}
+}
+
+// Is reports whether target is the sentinel error corresponding to e's
+// StatusCode, so errors.Is(err, firecrawl.ErrRateLimited) works.
+func (e *APIError) Is(target error) bool {
+	return statusSentinels[e.StatusCode] == target
+}
+
+// Unwrap returns the sentinel error corresponding to e's StatusCode, or nil
+// for status codes without one, so errors.Is also works via the standard
+// unwrap chain.
+func (e *APIError) Unwrap() error {
+	return statusSentinels[e.StatusCode]
+}
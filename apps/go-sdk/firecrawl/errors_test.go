@@ -0,0 +1,39 @@
+package firecrawl
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHandleErrorMatchesSentinelViaErrorsIs(t *testing.T) {
+	err := (&FirecrawlApp{}).handleError(http.StatusTooManyRequests, []byte(`{"error": "slow down"}`), "scrape URL", 3*time.Second)
+
+	assert.ErrorIs(t, err, ErrRateLimited)
+	assert.NotErrorIs(t, err, ErrServer)
+
+	var apiErr *APIError
+	assert.True(t, errors.As(err, &apiErr))
+	assert.Equal(t, http.StatusTooManyRequests, apiErr.StatusCode)
+	assert.Equal(t, "scrape URL", apiErr.Action)
+	assert.Equal(t, "slow down", apiErr.Message)
+	assert.Equal(t, 3*time.Second, apiErr.RetryAfter)
+}
+
+func TestHandleErrorUnknownStatusHasNoSentinel(t *testing.T) {
+	err := (&FirecrawlApp{}).handleError(http.StatusTeapot, []byte(`{"error": "brewing"}`), "scrape URL", 0)
+
+	assert.NotErrorIs(t, err, ErrRateLimited)
+	assert.NotErrorIs(t, err, ErrServer)
+	assert.Contains(t, err.Error(), "brewing")
+}
+
+func TestHandleErrorToleratesUnparseableBody(t *testing.T) {
+	err := (&FirecrawlApp{}).handleError(http.StatusInternalServerError, []byte("not json"), "scrape URL", 0)
+
+	assert.ErrorIs(t, err, ErrServer)
+	assert.Contains(t, err.Error(), "no additional error details provided")
+}
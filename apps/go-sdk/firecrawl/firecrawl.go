@@ -3,12 +3,14 @@ package firecrawl
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
-	"math"
 	"net/http"
 	"os"
+	"strconv"
+	"sync"
 	"time"
 )
 
@@ -46,6 +48,17 @@ type FirecrawlDocumentMetadata struct {
 	SourceURL         string   `json:"sourceURL,omitempty"`
 	PageStatusCode    int      `json:"pageStatusCode,omitempty"`
 	PageError         string   `json:"pageError,omitempty"`
+
+	// Headers holds the raw response headers from fetching the page, when
+	// the server provides them. Useful for indexers that filter on
+	// Content-Type or caching headers.
+	Headers map[string]string `json:"headers,omitempty"`
+	// Meta holds every `<meta name="..." content="...">` pair found on the
+	// page, keyed by name, including ones not already promoted to a
+	// dedicated field above.
+	Meta map[string]string `json:"meta,omitempty"`
+	// FetchedAt is when the page was fetched, when the server provides it.
+	FetchedAt *time.Time `json:"fetchedAt,omitempty"`
 }
 
 // FirecrawlDocument represents a document in Firecrawl
@@ -64,6 +77,14 @@ type FirecrawlDocument struct {
 	Provider      string                     `json:"provider,omitempty"`
 	Warning       string                     `json:"warning,omitempty"`
 	Index         int                        `json:"index,omitempty"`
+
+	// Scraped holds the findings of any local data scrapers requested via
+	// the "Scrapers" ScrapeURL option, keyed by the names each scraper
+	// reports (the rule name for the built-in rules, e.g. "emails").
+	// It has no API-side counterpart; the JSON-based sinks (JSONLSink,
+	// DirSink, S3Sink) store it under its own "scraped" key, while
+	// SQLiteSink does not store it.
+	Scraped map[string][]string `json:"scraped,omitempty"`
 }
 
 // ExtractorOptions represents options for extraction.
@@ -111,61 +132,16 @@ type CancelCrawlJobResponse struct {
 	Status  string `json:"status"`
 }
 
-// requestOptions represents options for making requests.
-type requestOptions struct {
-	retries int
-	backoff int
-}
-
-// requestOption is a functional option type for requestOptions.
-type requestOption func(*requestOptions)
-
-// newRequestOptions creates a new requestOptions instance with the provided options.
-//
-// Parameters:
-//   - opts: Optional request options.
-//
-// Returns:
-//   - *requestOptions: A new instance of requestOptions with the provided options.
-func newRequestOptions(opts ...requestOption) *requestOptions {
-	options := &requestOptions{retries: 1}
-	for _, opt := range opts {
-		opt(options)
-	}
-	return options
-}
-
-// withRetries sets the number of retries for a request.
-//
-// Parameters:
-//   - retries: The number of retries to be performed.
-//
-// Returns:
-//   - requestOption: A functional option that sets the number of retries for a request.
-func withRetries(retries int) requestOption {
-	return func(opts *requestOptions) {
-		opts.retries = retries
-	}
-}
-
-// withBackoff sets the backoff interval for a request.
-//
-// Parameters:
-//   - backoff: The backoff interval (in milliseconds) to be used for retries.
-//
-// Returns:
-//   - requestOption: A functional option that sets the backoff interval for a request.
-func withBackoff(backoff int) requestOption {
-	return func(opts *requestOptions) {
-		opts.backoff = backoff
-	}
-}
-
 // FirecrawlApp represents a client for the Firecrawl API.
 type FirecrawlApp struct {
-	APIKey string
-	APIURL string
-	Client *http.Client
+	APIKey      string
+	APIURL      string
+	Client      *http.Client
+	Options     ClientOptions
+	RetryPolicy RetryPolicy
+
+	converterMu sync.RWMutex
+	converter   HTMLToMarkdown
 }
 
 // NewFirecrawlApp creates a new instance of FirecrawlApp with the provided API key and API URL.
@@ -180,6 +156,22 @@ type FirecrawlApp struct {
 //   - *FirecrawlApp: A new instance of FirecrawlApp configured with the provided or retrieved API key and API URL.
 //   - error: An error if the API key is not provided or retrieved.
 func NewFirecrawlApp(apiKey, apiURL string) (*FirecrawlApp, error) {
+	return NewFirecrawlAppWithOptions(apiKey, apiURL, ClientOptions{})
+}
+
+// NewFirecrawlAppWithOptions creates a new instance of FirecrawlApp like
+// NewFirecrawlApp, additionally configuring client-side behavior (such as
+// local Markdown conversion) via clientOptions.
+//
+// Parameters:
+//   - apiKey: The API key for authenticating with the Firecrawl API. If empty, it will be retrieved from the FIRECRAWL_API_KEY environment variable.
+//   - apiURL: The base URL for the Firecrawl API. If empty, it will be retrieved from the FIRECRAWL_API_URL environment variable, defaulting to "https://api.firecrawl.dev".
+//   - clientOptions: Client-side options, such as enabling local HTML-to-Markdown conversion.
+//
+// Returns:
+//   - *FirecrawlApp: A new instance of FirecrawlApp configured with the provided or retrieved API key, API URL, and options.
+//   - error: An error if the API key is not provided or retrieved.
+func NewFirecrawlAppWithOptions(apiKey, apiURL string, clientOptions ClientOptions) (*FirecrawlApp, error) {
 	if apiKey == "" {
 		apiKey = os.Getenv("FIRECRAWL_API_KEY")
 		if apiKey == "" {
@@ -194,14 +186,22 @@ func NewFirecrawlApp(apiKey, apiURL string) (*FirecrawlApp, error) {
 		}
 	}
 
-	client := &http.Client{
-		Timeout: 60 * time.Second,
+	client, err := newHTTPClient(clientOptions)
+	if err != nil {
+		return nil, err
+	}
+
+	retryPolicy := clientOptions.RetryPolicy
+	if retryPolicy.MaxAttempts <= 0 {
+		retryPolicy = DefaultRetryPolicy
 	}
 
 	return &FirecrawlApp{
-		APIKey: apiKey,
-		APIURL: apiURL,
-		Client: client,
+		APIKey:      apiKey,
+		APIURL:      apiURL,
+		Client:      client,
+		Options:     clientOptions,
+		RetryPolicy: retryPolicy,
 	}, nil
 }
 
@@ -209,17 +209,34 @@ func NewFirecrawlApp(apiKey, apiURL string) (*FirecrawlApp, error) {
 //
 // Parameters:
 //   - url: The URL to be scraped.
-//   - params: Optional parameters for the scrape request, including extractor options for LLM extraction.
+//   - params: Optional parameters for the scrape request, either a map[string]any or a *ScrapeOptions/ScrapeOptions value.
 //
 // Returns:
 //   - *FirecrawlDocument: The scraped document data.
 //   - error: An error if the scrape request fails.
-func (app *FirecrawlApp) ScrapeURL(url string, params map[string]any) (*FirecrawlDocument, error) {
-	headers := app.prepareHeaders("")
+func (app *FirecrawlApp) ScrapeURL(url string, params any) (*FirecrawlDocument, error) {
+	return app.ScrapeURLContext(context.Background(), url, params)
+}
+
+// ScrapeURLContext is like ScrapeURL but carries ctx through the request,
+// including any retries, so the caller can cancel or time it out.
+func (app *FirecrawlApp) ScrapeURLContext(ctx context.Context, url string, params any) (*FirecrawlDocument, error) {
+	return app.scrapeURLContext(ctx, url, params, "")
+}
+
+// scrapeURLContext is ScrapeURLContext with an additional idempotency key
+// sent as the x-idempotency-key header, used by ScrapeURLs (via BatchOptions.IdempotencyKey).
+func (app *FirecrawlApp) scrapeURLContext(ctx context.Context, url string, params any, idempotencyKey string) (*FirecrawlDocument, error) {
+	headers := app.prepareHeaders(idempotencyKey)
 	scrapeBody := map[string]any{"url": url}
 
-	if params != nil {
-		if extractorOptions, ok := params["extractorOptions"].(ExtractorOptions); ok {
+	resolvedParams, err := resolveParams(params)
+	if err != nil {
+		return nil, err
+	}
+
+	if resolvedParams != nil {
+		if extractorOptions, ok := resolvedParams["extractorOptions"].(ExtractorOptions); ok {
 			if schema, ok := extractorOptions.ExtractionSchema.(interface{ schema() any }); ok {
 				extractorOptions.ExtractionSchema = schema.schema()
 			}
@@ -229,14 +246,29 @@ func (app *FirecrawlApp) ScrapeURL(url string, params map[string]any) (*Firecraw
 			scrapeBody["extractorOptions"] = extractorOptions
 		}
 
-		for key, value := range params {
+		for key, value := range resolvedParams {
 			if key != "extractorOptions" {
 				scrapeBody[key] = value
 			}
 		}
 	}
 
+	// "scrapers" runs entirely client-side against the response, so it must
+	// not be sent to the API.
+	scraperRules, _ := scrapeBody["scrapers"].([]string)
+	delete(scrapeBody, "scrapers")
+
+	conversionMode := app.effectiveConversionMode()
+	if conversionMode != ConversionModeRemote {
+		if pageOptions, ok := scrapeBody["pageOptions"].(map[string]any); ok {
+			pageOptions["includeHtml"] = true
+		} else {
+			scrapeBody["pageOptions"] = map[string]any{"includeHtml": true}
+		}
+	}
+
 	resp, err := app.makeRequest(
+		ctx,
 		http.MethodPost,
 		fmt.Sprintf("%s/v0/scrape", app.APIURL),
 		scrapeBody,
@@ -253,11 +285,28 @@ func (app *FirecrawlApp) ScrapeURL(url string, params map[string]any) (*Firecraw
 		return nil, err
 	}
 
-	if scrapeResponse.Success {
-		return scrapeResponse.Data, nil
+	if !scrapeResponse.Success {
+		return nil, fmt.Errorf("failed to scrape URL")
+	}
+
+	shouldConvertLocally := scrapeResponse.Data != nil &&
+		(conversionMode == ConversionModeLocal ||
+			(conversionMode == ConversionModeLocalFallback && scrapeResponse.Data.Markdown == ""))
+	if shouldConvertLocally {
+		if err := app.convertHTMLLocally(scrapeResponse.Data); err != nil {
+			return nil, fmt.Errorf("failed to convert HTML to markdown locally: %w", err)
+		}
 	}
 
-	return nil, fmt.Errorf("failed to scrape URL")
+	if len(scraperRules) > 0 && scrapeResponse.Data != nil {
+		scraped, err := runScrapers(scrapeResponse.Data, scraperRules)
+		if err != nil {
+			return nil, fmt.Errorf("failed to run scrapers: %w", err)
+		}
+		scrapeResponse.Data.Scraped = scraped
+	}
+
+	return scrapeResponse.Data, nil
 }
 
 // Search performs a search query using the Firecrawl API and returns the search results.
@@ -270,6 +319,12 @@ func (app *FirecrawlApp) ScrapeURL(url string, params map[string]any) (*Firecraw
 //   - []*FirecrawlDocument: A slice of FirecrawlDocument containing the search results.
 //   - error: An error if the search request fails.
 func (app *FirecrawlApp) Search(query string, params map[string]any) ([]*FirecrawlDocument, error) {
+	return app.SearchContext(context.Background(), query, params)
+}
+
+// SearchContext is like Search but carries ctx through the request,
+// including any retries, so the caller can cancel or time it out.
+func (app *FirecrawlApp) SearchContext(ctx context.Context, query string, params map[string]any) ([]*FirecrawlDocument, error) {
 	headers := app.prepareHeaders("")
 	searchBody := map[string]any{"query": query}
 	for k, v := range params {
@@ -277,6 +332,7 @@ func (app *FirecrawlApp) Search(query string, params map[string]any) ([]*Firecra
 	}
 
 	resp, err := app.makeRequest(
+		ctx,
 		http.MethodPost,
 		fmt.Sprintf("%s/v0/search", app.APIURL),
 		searchBody,
@@ -304,7 +360,7 @@ func (app *FirecrawlApp) Search(query string, params map[string]any) ([]*Firecra
 //
 // Parameters:
 //   - url: The URL to crawl.
-//   - params: Optional parameters for the crawl request.
+//   - params: Optional parameters for the crawl request, either a map[string]any or a *CrawlOptions/CrawlOptions value.
 //   - waitUntilDone: If true, the method will wait until the crawl job is completed before returning.
 //   - pollInterval: The interval (in seconds) at which to poll the job status if waitUntilDone is true.
 //   - idempotencyKey: An optional idempotency key to ensure the request is idempotent.
@@ -312,21 +368,32 @@ func (app *FirecrawlApp) Search(query string, params map[string]any) ([]*Firecra
 // Returns:
 //   - any: The job ID if waitUntilDone is false, or the crawl result if waitUntilDone is true.
 //   - error: An error if the crawl request fails.
-func (app *FirecrawlApp) CrawlURL(url string, params map[string]any, waitUntilDone bool, pollInterval int, idempotencyKey string) (any, error) {
+func (app *FirecrawlApp) CrawlURL(url string, params any, waitUntilDone bool, pollInterval int, idempotencyKey string) (any, error) {
+	return app.CrawlURLContext(context.Background(), url, params, waitUntilDone, pollInterval, idempotencyKey)
+}
+
+// CrawlURLContext is like CrawlURL but carries ctx through the request and,
+// if waitUntilDone is true, through the status polling performed by
+// MonitorJobStatusContext, so the caller can cancel or time it out.
+func (app *FirecrawlApp) CrawlURLContext(ctx context.Context, url string, params any, waitUntilDone bool, pollInterval int, idempotencyKey string) (any, error) {
 	headers := app.prepareHeaders(idempotencyKey)
 	crawlBody := map[string]any{"url": url}
-	for k, v := range params {
+
+	resolvedParams, err := resolveParams(params)
+	if err != nil {
+		return nil, err
+	}
+	for k, v := range resolvedParams {
 		crawlBody[k] = v
 	}
 
 	resp, err := app.makeRequest(
+		ctx,
 		http.MethodPost,
 		fmt.Sprintf("%s/v0/crawl", app.APIURL),
 		crawlBody,
 		headers,
 		"start crawl job",
-		withRetries(3),
-		withBackoff(500),
 	)
 	if err != nil {
 		return nil, err
@@ -339,7 +406,7 @@ func (app *FirecrawlApp) CrawlURL(url string, params map[string]any, waitUntilDo
 	}
 
 	if waitUntilDone {
-		return app.monitorJobStatus(crawlResponse.JobID, headers, pollInterval)
+		return app.MonitorJobStatusContext(ctx, crawlResponse.JobID, headers, pollInterval)
 	}
 
 	if crawlResponse.JobID == "" {
@@ -358,15 +425,20 @@ func (app *FirecrawlApp) CrawlURL(url string, params map[string]any, waitUntilDo
 //   - *JobStatusResponse: The status of the crawl job.
 //   - error: An error if the crawl status check request fails.
 func (app *FirecrawlApp) CheckCrawlStatus(jobID string) (*JobStatusResponse, error) {
+	return app.CheckCrawlStatusContext(context.Background(), jobID)
+}
+
+// CheckCrawlStatusContext is like CheckCrawlStatus but carries ctx through
+// the request, including any retries, so the caller can cancel or time it out.
+func (app *FirecrawlApp) CheckCrawlStatusContext(ctx context.Context, jobID string) (*JobStatusResponse, error) {
 	headers := app.prepareHeaders("")
 	resp, err := app.makeRequest(
+		ctx,
 		http.MethodGet,
 		fmt.Sprintf("%s/v0/crawl/status/%s", app.APIURL, jobID),
 		nil,
 		headers,
 		"check crawl status",
-		withRetries(3),
-		withBackoff(500),
 	)
 	if err != nil {
 		return nil, err
@@ -390,8 +462,15 @@ func (app *FirecrawlApp) CheckCrawlStatus(jobID string) (*JobStatusResponse, err
 //   - string: The status of the crawl job after cancellation.
 //   - error: An error if the crawl job cancellation request fails.
 func (app *FirecrawlApp) CancelCrawlJob(jobID string) (string, error) {
+	return app.CancelCrawlJobContext(context.Background(), jobID)
+}
+
+// CancelCrawlJobContext is like CancelCrawlJob but carries ctx through the
+// request, including any retries, so the caller can cancel or time it out.
+func (app *FirecrawlApp) CancelCrawlJobContext(ctx context.Context, jobID string) (string, error) {
 	headers := app.prepareHeaders("")
 	resp, err := app.makeRequest(
+		ctx,
 		http.MethodDelete,
 		fmt.Sprintf("%s/v0/crawl/cancel/%s", app.APIURL, jobID),
 		nil,
@@ -430,88 +509,154 @@ func (app *FirecrawlApp) prepareHeaders(idempotencyKey string) map[string]string
 	return headers
 }
 
-// makeRequest makes a request to the specified URL with the provided method, data, headers, and options.
+// makeRequest makes a request to the specified URL with the provided method,
+// data, headers, and action description, retrying per app.RetryPolicy.
+//
+// Unlike earlier versions of this method, the request body is re-encoded on
+// every attempt rather than reusing a single already-consumed buffer, and
+// ctx is honored both as the request's context and between retries.
 //
 // Parameters:
+//   - ctx: Controls cancellation of the request and any retries.
 //   - method: The HTTP method to use for the request (e.g., "GET", "POST", "DELETE").
 //   - url: The URL to send the request to.
 //   - data: The data to be sent in the request body.
 //   - headers: The headers to be included in the request.
 //   - action: A string describing the action being performed.
-//   - opts: Optional request options.
 //
 // Returns:
 //   - []byte: The response body from the request.
 //   - error: An error if the request fails.
-func (app *FirecrawlApp) makeRequest(method, url string, data map[string]any, headers map[string]string, action string, opts ...requestOption) ([]byte, error) {
+func (app *FirecrawlApp) makeRequest(ctx context.Context, method, url string, data map[string]any, headers map[string]string, action string) ([]byte, error) {
 	var body []byte
-	var err error
 	if data != nil {
-		body, err = json.Marshal(data)
+		encoded, err := json.Marshal(data)
 		if err != nil {
 			return nil, err
 		}
+		body = encoded
 	}
 
-	req, err := http.NewRequest(method, url, bytes.NewBuffer(body))
-	if err != nil {
-		return nil, err
+	retryPolicy := app.RetryPolicy
+	if retryPolicy.MaxAttempts <= 0 {
+		retryPolicy = DefaultRetryPolicy
 	}
 
-	for key, value := range headers {
-		req.Header.Set(key, value)
-	}
+	var lastErr error
+	for attempt := 0; attempt < retryPolicy.MaxAttempts; attempt++ {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		req, err := http.NewRequestWithContext(ctx, method, url, bytes.NewReader(body))
+		if err != nil {
+			return nil, err
+		}
+		for key, value := range headers {
+			req.Header.Set(key, value)
+		}
 
-	var resp *http.Response
-	options := newRequestOptions(opts...)
-	for i := 0; i < options.retries; i++ {
-		resp, err = app.Client.Do(req)
+		resp, err := app.Client.Do(req)
+		if err != nil {
+			lastErr = err
+			if !app.waitBeforeRetry(ctx, retryPolicy, attempt, 0) {
+				if ctxErr := ctx.Err(); ctxErr != nil {
+					return nil, ctxErr
+				}
+				return nil, lastErr
+			}
+			continue
+		}
+
+		respBody, err := io.ReadAll(resp.Body)
+		resp.Body.Close()
 		if err != nil {
 			return nil, err
 		}
-		defer resp.Body.Close()
 
-		if resp.StatusCode != 502 {
-			break
+		if resp.StatusCode == http.StatusOK {
+			return respBody, nil
+		}
+
+		retryAfter := parseRetryAfter(resp.Header.Get("Retry-After"))
+		lastErr = app.handleError(resp.StatusCode, respBody, action, retryAfter)
+		if !retryPolicy.isRetryableStatus(resp.StatusCode) {
+			return nil, lastErr
 		}
+		if !app.waitBeforeRetry(ctx, retryPolicy, attempt, retryAfter) {
+			if ctxErr := ctx.Err(); ctxErr != nil {
+				return nil, ctxErr
+			}
+			return nil, lastErr
+		}
+	}
 
-		time.Sleep(time.Duration(math.Pow(2, float64(i))) * time.Duration(options.backoff) * time.Millisecond)
+	return nil, lastErr
+}
+
+// waitBeforeRetry sleeps for retryAfter if positive, otherwise for
+// retryPolicy's full-jitter backoff for attempt, unless ctx finishes or
+// attempt was the last one, in which case it returns false without sleeping.
+func (app *FirecrawlApp) waitBeforeRetry(ctx context.Context, retryPolicy RetryPolicy, attempt int, retryAfter time.Duration) bool {
+	if attempt >= retryPolicy.MaxAttempts-1 {
+		return false
 	}
 
-	respBody, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return nil, err
+	wait := retryAfter
+	if wait <= 0 {
+		wait = retryPolicy.backoff(attempt)
 	}
 
-	statusCode := resp.StatusCode
-	if statusCode != 200 {
-		return nil, app.handleError(statusCode, respBody, action)
+	timer := time.NewTimer(wait)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return true
+	case <-ctx.Done():
+		return false
 	}
+}
 
-	return respBody, nil
+// parseRetryAfter parses an HTTP Retry-After header value expressed in
+// seconds into a time.Duration, returning 0 if value is empty or malformed
+// (HTTP-date Retry-After values are not supported).
+func parseRetryAfter(value string) time.Duration {
+	if value == "" {
+		return 0
+	}
+	seconds, err := strconv.Atoi(value)
+	if err != nil || seconds < 0 {
+		return 0
+	}
+	return time.Duration(seconds) * time.Second
 }
 
-// monitorJobStatus monitors the status of a crawl job using the Firecrawl API.
+// MonitorJobStatusContext polls a crawl job's status until it completes,
+// fails, or ctx is done, returning the crawl's documents once completed.
 //
 // Parameters:
+//   - ctx: Controls cancellation of the polling loop.
 //   - jobID: The ID of the crawl job to monitor.
-//   - headers: The headers to be included in the request.
+//   - headers: The headers to be included in each status request.
 //   - pollInterval: The interval (in seconds) at which to poll the job status.
 //
 // Returns:
 //   - []*FirecrawlDocument: The crawl result if the job is completed.
-//   - error: An error if the crawl status check request fails.
-func (app *FirecrawlApp) monitorJobStatus(jobID string, headers map[string]string, pollInterval int) ([]*FirecrawlDocument, error) {
+//   - error: An error if the crawl status check request fails, the job fails, or ctx finishes first.
+func (app *FirecrawlApp) MonitorJobStatusContext(ctx context.Context, jobID string, headers map[string]string, pollInterval int) ([]*FirecrawlDocument, error) {
 	attempts := 0
 	for {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
 		resp, err := app.makeRequest(
+			ctx,
 			http.MethodGet,
 			fmt.Sprintf("%s/v0/crawl/status/%s", app.APIURL, jobID),
 			nil,
 			headers,
 			"check crawl status",
-			withRetries(3),
-			withBackoff(500),
 		)
 		if err != nil {
 			return nil, err
@@ -538,47 +683,40 @@ func (app *FirecrawlApp) monitorJobStatus(jobID string, headers map[string]strin
 			}
 		} else if status == "active" || status == "paused" || status == "pending" || status == "queued" || status == "waiting" {
 			pollInterval = max(pollInterval, 2)
-			time.Sleep(time.Duration(pollInterval) * time.Second)
+			timer := time.NewTimer(time.Duration(pollInterval) * time.Second)
+			select {
+			case <-timer.C:
+			case <-ctx.Done():
+				timer.Stop()
+				return nil, ctx.Err()
+			}
 		} else {
 			return nil, fmt.Errorf("crawl job failed or was stopped. Status: %s", status)
 		}
 	}
 }
 
-// handleError handles errors returned by the Firecrawl API.
+// handleError builds an *APIError describing a non-2xx response from the
+// Firecrawl API.
 //
 // Parameters:
-//   - resp: The HTTP response object.
+//   - statusCode: The HTTP status code of the response.
 //   - body: The response body from the HTTP response.
 //   - action: A string describing the action being performed.
+//   - retryAfter: The duration parsed from the response's Retry-After header, if any.
 //
 // Returns:
-//   - error: An error describing the failure reason.
-func (app *FirecrawlApp) handleError(statusCode int, body []byte, action string) error {
+//   - error: An *APIError describing the failure reason.
+func (app *FirecrawlApp) handleError(statusCode int, body []byte, action string, retryAfter time.Duration) error {
 	var errorData map[string]any
-	err := json.Unmarshal(body, &errorData)
-	if err != nil {
-		return fmt.Errorf("failed to parse error response: %v", err)
-	}
+	_ = json.Unmarshal(body, &errorData) // best-effort; Message falls back to "" below
 
-	errorMessage, _ := errorData["error"].(string)
-	if errorMessage == "" {
-		errorMessage = "No additional error details provided."
+	message, _ := errorData["error"].(string)
+	return &APIError{
+		StatusCode: statusCode,
+		Action:     action,
+		Message:    message,
+		RawBody:    body,
+		RetryAfter: retryAfter,
 	}
-
-	var message string
-	switch statusCode {
-	case 402:
-		message = fmt.Sprintf("Payment Required: Failed to %s. %s", action, errorMessage)
-	case 408:
-		message = fmt.Sprintf("Request Timeout: Failed to %s as the request timed out. %s", action, errorMessage)
-	case 409:
-		message = fmt.Sprintf("Conflict: Failed to %s due to a conflict. %s", action, errorMessage)
-	case 500:
-		message = fmt.Sprintf("Internal Server Error: Failed to %s. %s", action, errorMessage)
-	default:
-		message = fmt.Sprintf("Unexpected error during %s: Status code %d. %s", action, statusCode, errorMessage)
-	}
-
-	return fmt.Errorf(message)
 }
@@ -1,6 +1,7 @@
 package firecrawl
 
 import (
+	"context"
 	"log"
 	"os"
 	"testing"
@@ -36,7 +37,7 @@ func TestScrapeURLInvalidAPIKey(t *testing.T) {
 
 	_, err = app.ScrapeURL("https://firecrawl.dev", nil)
 	assert.Error(t, err)
-	assert.Contains(t, err.Error(), "Unexpected error during scrape URL: Status code 401. Unauthorized: Invalid token")
+	assert.Contains(t, err.Error(), "unauthorized: failed to scrape URL: Unauthorized: Invalid token")
 }
 
 func TestBlocklistedURL(t *testing.T) {
@@ -45,7 +46,7 @@ func TestBlocklistedURL(t *testing.T) {
 
 	_, err = app.ScrapeURL("https://facebook.com/fake-test", nil)
 	assert.Error(t, err)
-	assert.Contains(t, err.Error(), "Unexpected error during scrape URL: Status code 403. Firecrawl currently does not support social media scraping due to policy restrictions.")
+	assert.Contains(t, err.Error(), "unexpected error during scrape URL: status code 403: Firecrawl currently does not support social media scraping due to policy restrictions.")
 }
 
 func TestSuccessfulResponseWithValidPreviewToken(t *testing.T) {
@@ -123,7 +124,7 @@ func TestCrawlURLInvalidAPIKey(t *testing.T) {
 
 	_, err = app.CrawlURL("https://firecrawl.dev", nil, false, 2, "")
 	assert.Error(t, err)
-	assert.Contains(t, err.Error(), "Unexpected error during start crawl job: Status code 401. Unauthorized: Invalid token")
+	assert.Contains(t, err.Error(), "unauthorized: failed to start crawl job: Unauthorized: Invalid token")
 }
 
 func TestShouldReturnErrorForBlocklistedURL(t *testing.T) {
@@ -132,7 +133,7 @@ func TestShouldReturnErrorForBlocklistedURL(t *testing.T) {
 
 	_, err = app.CrawlURL("https://twitter.com/fake-test", nil, false, 2, "")
 	assert.Error(t, err)
-	assert.Contains(t, err.Error(), "Unexpected error during start crawl job: Status code 403. Firecrawl currently does not support social media scraping due to policy restrictions.")
+	assert.Contains(t, err.Error(), "unexpected error during start crawl job: status code 403: Firecrawl currently does not support social media scraping due to policy restrictions.")
 }
 
 func TestCrawlURLWaitForCompletionE2E(t *testing.T) {
@@ -175,7 +176,7 @@ func TestCrawlURLWithIdempotencyKeyE2E(t *testing.T) {
 
 	_, err = app.CrawlURL("https://firecrawl.dev", params, true, 2, uniqueIdempotencyKey)
 	assert.Error(t, err)
-	assert.Contains(t, err.Error(), "Conflict: Failed to start crawl job due to a conflict. Idempotency key already used")
+	assert.Contains(t, err.Error(), "conflict: failed to start crawl job due to a conflict: Idempotency key already used")
 }
 
 func TestCheckCrawlStatusE2E(t *testing.T) {
@@ -223,7 +224,7 @@ func TestSearchInvalidAPIKey(t *testing.T) {
 
 	_, err = app.Search("test query", nil)
 	assert.Error(t, err)
-	assert.Contains(t, err.Error(), "Unexpected error during search: Status code 401. Unauthorized: Invalid token")
+	assert.Contains(t, err.Error(), "unauthorized: failed to search: Unauthorized: Invalid token")
 }
 
 func TestLLMExtraction(t *testing.T) {
@@ -261,7 +262,7 @@ func TestCancelCrawlJobInvalidAPIKey(t *testing.T) {
 
 	_, err = app.CancelCrawlJob("test query")
 	assert.Error(t, err)
-	assert.Contains(t, err.Error(), "Unexpected error during cancel crawl job: Status code 401. Unauthorized: Invalid token")
+	assert.Contains(t, err.Error(), "unauthorized: failed to cancel crawl job: Unauthorized: Invalid token")
 }
 
 func TestCancelNonExistingCrawlJob(t *testing.T) {
@@ -290,3 +291,79 @@ func TestCancelCrawlJobE2E(t *testing.T) {
 	require.NoError(t, err)
 	assert.Equal(t, "cancelled", status)
 }
+
+func TestCrawlURLStreamInvalidAPIKey(t *testing.T) {
+	app, err := NewFirecrawlApp("invalid_api_key", API_URL)
+	require.NoError(t, err)
+
+	err = app.CrawlURLStream(context.Background(), "https://firecrawl.dev", nil, "", func(doc *FirecrawlDocument) error {
+		t.Fatal("handler should not be called when the crawl job fails to start")
+		return nil
+	})
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "unauthorized: failed to start crawl job: Unauthorized: Invalid token")
+}
+
+func TestCrawlURLStreamRespectsContextCancellation(t *testing.T) {
+	app, err := NewFirecrawlApp(TEST_API_KEY, API_URL)
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 1*time.Millisecond)
+	defer cancel()
+
+	params := map[string]any{
+		"crawlerOptions": map[string]any{
+			"excludes": []string{"blog/*"},
+		},
+	}
+	err = app.CrawlURLStream(ctx, "https://roastmywebsite.ai", params, "", func(doc *FirecrawlDocument) error {
+		return nil
+	})
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
+func TestSubscribeCrawlDeliversDocumentsAndCompletes(t *testing.T) {
+	app, err := NewFirecrawlApp(TEST_API_KEY, API_URL)
+	require.NoError(t, err)
+
+	response, err := app.CrawlURL("https://roastmywebsite.ai", nil, false, 0, "")
+	require.NoError(t, err)
+	jobID, ok := response.(string)
+	require.True(t, ok)
+
+	events, err := app.SubscribeCrawl(context.Background(), jobID, 2*time.Second)
+	require.NoError(t, err)
+
+	var sawCompleted bool
+	for event := range events {
+		switch event.Type {
+		case CrawlEventDocument:
+			assert.NotNil(t, event.Document)
+		case CrawlEventFailed:
+			t.Fatalf("crawl failed: %v", event.Err)
+		case CrawlEventCompleted:
+			sawCompleted = true
+		}
+	}
+	assert.True(t, sawCompleted)
+}
+
+func TestSubscribeCrawlRespectsContextCancellation(t *testing.T) {
+	app, err := NewFirecrawlApp(TEST_API_KEY, API_URL)
+	require.NoError(t, err)
+
+	response, err := app.CrawlURL("https://roastmywebsite.ai", nil, false, 0, "")
+	require.NoError(t, err)
+	jobID, ok := response.(string)
+	require.True(t, ok)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 1*time.Millisecond)
+	defer cancel()
+
+	events, err := app.SubscribeCrawl(ctx, jobID, 2*time.Second)
+	require.NoError(t, err)
+
+	for range events {
+	}
+	_, _ = app.CancelCrawlJob(jobID)
+}
@@ -0,0 +1,185 @@
+package firecrawl
+
+import (
+	"net/http"
+
+	md "github.com/JohannesKaufmann/html-to-markdown"
+	"github.com/JohannesKaufmann/html-to-markdown/plugin"
+)
+
+// ConversionMode controls whether ScrapeURL's Markdown comes from the
+// Firecrawl API or is rendered client-side from the fetched HTML.
+type ConversionMode int
+
+const (
+	// ConversionModeRemote uses the Markdown returned by the Firecrawl API
+	// as-is. This is the default.
+	ConversionModeRemote ConversionMode = iota
+	// ConversionModeLocal always renders Markdown client-side from the
+	// response's HTML via the configured HTMLToMarkdown converter, ignoring
+	// any Markdown the API returned.
+	ConversionModeLocal
+	// ConversionModeLocalFallback uses the API's Markdown when present and
+	// falls back to rendering it client-side from HTML when the API
+	// returned none.
+	ConversionModeLocalFallback
+)
+
+// HTMLToMarkdown converts raw HTML into Markdown. Implementations are used
+// by FirecrawlApp to render Markdown locally instead of relying on the
+// Markdown produced by the Firecrawl API.
+//
+// Parameters:
+//   - html: The raw HTML to convert.
+//   - baseURL: The URL the HTML was fetched from, used to resolve relative
+//     links and images. May be empty if unknown.
+//
+// Returns:
+//   - string: The converted Markdown.
+//   - error: An error if the conversion fails.
+type HTMLToMarkdown interface {
+	Convert(html string, baseURL string) (string, error)
+}
+
+// ClientOptions configures optional, client-side behavior of a FirecrawlApp
+// that goes beyond simply calling the Firecrawl API, such as converting
+// scraped HTML to Markdown locally.
+type ClientOptions struct {
+	// LocalMarkdown, when true, makes ScrapeURL fetch HTML (forcing
+	// includeHtml on the request) and convert it to Markdown locally via
+	// Converter instead of using the Markdown returned by the API.
+	//
+	// Deprecated: set ConversionMode to ConversionModeLocal instead. Kept
+	// for backward compatibility; if ConversionMode is left at its zero
+	// value (ConversionModeRemote), LocalMarkdown=true is treated the same
+	// as ConversionModeLocal.
+	LocalMarkdown bool
+
+	// ConversionMode controls whether ScrapeURL uses the API's Markdown, a
+	// locally-rendered one, or falls back to a local one only when the API
+	// didn't return Markdown. Defaults to ConversionModeRemote.
+	ConversionMode ConversionMode
+
+	// Converter is the HTMLToMarkdown implementation used for local
+	// conversion (ConversionModeLocal, ConversionModeLocalFallback, or the
+	// deprecated LocalMarkdown). If nil, a default converter backed by
+	// github.com/JohannesKaufmann/html-to-markdown is used.
+	Converter HTMLToMarkdown
+
+	// PreProcessHTML, if set, runs on the raw HTML before it is handed to
+	// Converter. It can be used to strip boilerplate or rewrite markup.
+	PreProcessHTML func(html string) (string, error)
+
+	// PostProcessMarkdown, if set, runs on the Markdown produced by
+	// Converter before it is stored on the FirecrawlDocument.
+	PostProcessMarkdown func(markdown string) (string, error)
+
+	// Transport, if set, is used as the http.Client's transport, overriding
+	// ProxyURL. Use this for full control over dialing, e.g. to reuse an
+	// existing proxy.Dialer.
+	Transport http.RoundTripper
+
+	// RetryPolicy controls how FirecrawlApp retries failed requests.
+	// Defaults to DefaultRetryPolicy when zero.
+	RetryPolicy RetryPolicy
+
+	// ProxyURL, if set and Transport is nil, routes all requests through
+	// the SOCKS5 proxy at this host:port (e.g. "127.0.0.1:9050" for a local
+	// Tor daemon), enabling .onion scraping and corporate proxy deployments.
+	// For a per-request proxy instead, pass a "proxy" key under
+	// "pageOptions" in the params map (or PageOptions.Proxy once using the
+	// typed option structs) so the server-side fetcher uses it for a single
+	// request.
+	ProxyURL string
+}
+
+// defaultHTMLToMarkdownConverter is the HTMLToMarkdown implementation used
+// when ClientOptions.Converter is not set. It wraps
+// github.com/JohannesKaufmann/html-to-markdown with the GitHub-flavored
+// plugin enabled.
+type defaultHTMLToMarkdownConverter struct{}
+
+// Convert implements HTMLToMarkdown.
+func (defaultHTMLToMarkdownConverter) Convert(html string, baseURL string) (string, error) {
+	converter := md.NewConverter(baseURL, true, nil)
+	converter.Use(plugin.GitHubFlavored())
+	return converter.ConvertString(html)
+}
+
+// effectiveConversionMode resolves app.Options.ConversionMode, honoring the
+// deprecated LocalMarkdown flag when ConversionMode was left at its zero value.
+func (app *FirecrawlApp) effectiveConversionMode() ConversionMode {
+	if app.Options.ConversionMode != ConversionModeRemote {
+		return app.Options.ConversionMode
+	}
+	if app.Options.LocalMarkdown {
+		return ConversionModeLocal
+	}
+	return ConversionModeRemote
+}
+
+// SetConverter overrides the HTMLToMarkdown implementation app uses for
+// local Markdown conversion, taking precedence over Options.Converter. It is
+// safe to call concurrently with ScrapeURL/ScrapeURLs, including while they
+// are in flight, since the underlying html-to-markdown library is not
+// itself safe for concurrent use and callers may need to swap in a fresh
+// converter instance per goroutine pool.
+func (app *FirecrawlApp) SetConverter(c HTMLToMarkdown) {
+	app.converterMu.Lock()
+	defer app.converterMu.Unlock()
+	app.converter = c
+}
+
+// getConverter returns the HTMLToMarkdown implementation to use: the one set
+// via SetConverter if any, else Options.Converter, else the default.
+func (app *FirecrawlApp) getConverter() HTMLToMarkdown {
+	app.converterMu.RLock()
+	defer app.converterMu.RUnlock()
+
+	if app.converter != nil {
+		return app.converter
+	}
+	if app.Options.Converter != nil {
+		return app.Options.Converter
+	}
+	return defaultHTMLToMarkdownConverter{}
+}
+
+// convertHTMLLocally runs doc.HTML through app's configured HTMLToMarkdown
+// converter, applying any configured pre/post-processing hooks, and stores
+// the result on doc.Markdown.
+//
+// Parameters:
+//   - doc: The document whose HTML should be converted. doc.Markdown is
+//     overwritten with the result.
+//
+// Returns:
+//   - error: An error if pre-processing, conversion, or post-processing fails.
+func (app *FirecrawlApp) convertHTMLLocally(doc *FirecrawlDocument) error {
+	html := doc.HTML
+	if app.Options.PreProcessHTML != nil {
+		processed, err := app.Options.PreProcessHTML(html)
+		if err != nil {
+			return err
+		}
+		html = processed
+	}
+
+	converter := app.getConverter()
+
+	markdown, err := converter.Convert(html, doc.URL)
+	if err != nil {
+		return err
+	}
+
+	if app.Options.PostProcessMarkdown != nil {
+		processed, err := app.Options.PostProcessMarkdown(markdown)
+		if err != nil {
+			return err
+		}
+		markdown = processed
+	}
+
+	doc.Markdown = markdown
+	return nil
+}
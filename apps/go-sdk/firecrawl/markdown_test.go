@@ -0,0 +1,87 @@
+package firecrawl
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const fixtureHTML = `<html><body><h1>Roast</h1><p>This is <strong>bold</strong>.</p><ul><li>one</li><li>two</li></ul></body></html>`
+
+func TestDefaultHTMLToMarkdownConverter(t *testing.T) {
+	converter := defaultHTMLToMarkdownConverter{}
+
+	markdown, err := converter.Convert(fixtureHTML, "https://roastmywebsite.ai")
+	require.NoError(t, err)
+
+	assert.Contains(t, markdown, "# Roast")
+	assert.Contains(t, markdown, "**bold**")
+	assert.Contains(t, markdown, "- one")
+	assert.Contains(t, markdown, "- two")
+}
+
+type upperCaseConverter struct{}
+
+func (upperCaseConverter) Convert(html string, baseURL string) (string, error) {
+	return strings.ToUpper(html), nil
+}
+
+func TestConvertHTMLLocallyUsesConfiguredConverterAndHooks(t *testing.T) {
+	app := &FirecrawlApp{
+		Options: ClientOptions{
+			Converter: upperCaseConverter{},
+			PreProcessHTML: func(html string) (string, error) {
+				return strings.TrimSpace(html), nil
+			},
+			PostProcessMarkdown: func(markdown string) (string, error) {
+				return markdown + "!", nil
+			},
+		},
+	}
+
+	doc := &FirecrawlDocument{URL: "https://example.com", HTML: "  <p>hi</p>  "}
+	err := app.convertHTMLLocally(doc)
+	require.NoError(t, err)
+
+	assert.Equal(t, "<P>HI</P>!", doc.Markdown)
+}
+
+func TestConvertHTMLLocallyDefaultsToDefaultConverter(t *testing.T) {
+	app := &FirecrawlApp{}
+
+	doc := &FirecrawlDocument{URL: "https://roastmywebsite.ai", HTML: fixtureHTML}
+	err := app.convertHTMLLocally(doc)
+	require.NoError(t, err)
+
+	assert.Contains(t, doc.Markdown, "# Roast")
+}
+
+func TestEffectiveConversionModeHonorsDeprecatedLocalMarkdown(t *testing.T) {
+	app := &FirecrawlApp{}
+	assert.Equal(t, ConversionModeRemote, app.effectiveConversionMode())
+
+	app.Options.LocalMarkdown = true
+	assert.Equal(t, ConversionModeLocal, app.effectiveConversionMode())
+
+	app.Options.ConversionMode = ConversionModeLocalFallback
+	assert.Equal(t, ConversionModeLocalFallback, app.effectiveConversionMode(), "explicit ConversionMode takes precedence over LocalMarkdown")
+}
+
+func TestSetConverterOverridesOptionsConverter(t *testing.T) {
+	app := &FirecrawlApp{Options: ClientOptions{Converter: upperCaseConverter{}}}
+	app.SetConverter(lowerCaseConverter{})
+
+	doc := &FirecrawlDocument{URL: "https://example.com", HTML: "<P>HI</P>"}
+	err := app.convertHTMLLocally(doc)
+	require.NoError(t, err)
+
+	assert.Equal(t, "<p>hi</p>", doc.Markdown)
+}
+
+type lowerCaseConverter struct{}
+
+func (lowerCaseConverter) Convert(html string, baseURL string) (string, error) {
+	return strings.ToLower(html), nil
+}
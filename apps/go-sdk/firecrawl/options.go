@@ -0,0 +1,201 @@
+package firecrawl
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// paramsConverter is implemented by typed option structs (CrawlerOptions,
+// PageOptions, ScrapeOptions, CrawlOptions) so ScrapeURL and CrawlURL can
+// accept either them or a plain map[string]any.
+type paramsConverter interface {
+	ToMap() map[string]any
+}
+
+// validator is implemented by typed option structs that can catch
+// malformed options before a request is sent.
+type validator interface {
+	Validate() error
+}
+
+// CrawlerOptions configures how CrawlURL traverses a site. It is the typed
+// equivalent of the "crawlerOptions" map entry.
+type CrawlerOptions struct {
+	Includes                  []string
+	Excludes                  []string
+	MaxDepth                  int
+	Limit                     int
+	AllowBackwardCrawling     bool
+	AllowExternalContentLinks bool
+}
+
+// ToMap implements paramsConverter.
+func (c CrawlerOptions) ToMap() map[string]any {
+	m := map[string]any{}
+	if len(c.Includes) > 0 {
+		m["includes"] = c.Includes
+	}
+	if len(c.Excludes) > 0 {
+		m["excludes"] = c.Excludes
+	}
+	if c.MaxDepth != 0 {
+		m["maxDepth"] = c.MaxDepth
+	}
+	if c.Limit != 0 {
+		m["limit"] = c.Limit
+	}
+	if c.AllowBackwardCrawling {
+		m["allowBackwardCrawling"] = c.AllowBackwardCrawling
+	}
+	if c.AllowExternalContentLinks {
+		m["allowExternalContentLinks"] = c.AllowExternalContentLinks
+	}
+	return m
+}
+
+// Validate implements validator.
+func (c CrawlerOptions) Validate() error {
+	if c.MaxDepth < 0 {
+		return fmt.Errorf("crawler options: maxDepth must not be negative, got %d", c.MaxDepth)
+	}
+	if c.Limit < 0 {
+		return fmt.Errorf("crawler options: limit must not be negative, got %d", c.Limit)
+	}
+	return nil
+}
+
+// PageOptions configures how a single page is fetched. It is the typed
+// equivalent of the "pageOptions" map entry.
+type PageOptions struct {
+	OnlyMainContent bool
+	IncludeHTML     bool
+	WaitFor         int
+	ScreenShot      bool
+	Headers         map[string]string
+	// Proxy, if set, tells the server-side fetcher to use this proxy for
+	// this request only. See ClientOptions.ProxyURL for client-side
+	// proxying of the Firecrawl API calls themselves.
+	Proxy string
+}
+
+// ToMap implements paramsConverter.
+func (p PageOptions) ToMap() map[string]any {
+	m := map[string]any{}
+	if p.OnlyMainContent {
+		m["onlyMainContent"] = p.OnlyMainContent
+	}
+	if p.IncludeHTML {
+		m["includeHtml"] = p.IncludeHTML
+	}
+	if p.WaitFor != 0 {
+		m["waitFor"] = p.WaitFor
+	}
+	if p.ScreenShot {
+		m["screenshot"] = p.ScreenShot
+	}
+	if len(p.Headers) > 0 {
+		m["headers"] = p.Headers
+	}
+	if p.Proxy != "" {
+		m["proxy"] = p.Proxy
+	}
+	return m
+}
+
+// Validate implements validator.
+func (p PageOptions) Validate() error {
+	if p.WaitFor < 0 {
+		return fmt.Errorf("page options: waitFor must not be negative, got %d", p.WaitFor)
+	}
+	return nil
+}
+
+// ScrapeOptions is the typed alternative to passing a map[string]any to
+// ScrapeURL.
+type ScrapeOptions struct {
+	PageOptions      *PageOptions
+	ExtractorOptions *ExtractorOptions
+	// Scrapers lists local data-scraper rule names (see RegisterScraper) to
+	// run against the response and attach to FirecrawlDocument.Scraped.
+	Scrapers []string
+}
+
+// ToMap implements paramsConverter.
+func (s ScrapeOptions) ToMap() map[string]any {
+	m := map[string]any{}
+	if s.PageOptions != nil {
+		m["pageOptions"] = s.PageOptions.ToMap()
+	}
+	if s.ExtractorOptions != nil {
+		m["extractorOptions"] = *s.ExtractorOptions
+	}
+	if len(s.Scrapers) > 0 {
+		m["scrapers"] = s.Scrapers
+	}
+	return m
+}
+
+// Validate implements validator.
+func (s ScrapeOptions) Validate() error {
+	if s.PageOptions != nil {
+		return s.PageOptions.Validate()
+	}
+	return nil
+}
+
+// CrawlOptions is the typed alternative to passing a map[string]any to CrawlURL.
+type CrawlOptions struct {
+	CrawlerOptions *CrawlerOptions
+	PageOptions    *PageOptions
+}
+
+// ToMap implements paramsConverter.
+func (c CrawlOptions) ToMap() map[string]any {
+	m := map[string]any{}
+	if c.CrawlerOptions != nil {
+		m["crawlerOptions"] = c.CrawlerOptions.ToMap()
+	}
+	if c.PageOptions != nil {
+		m["pageOptions"] = c.PageOptions.ToMap()
+	}
+	return m
+}
+
+// Validate implements validator.
+func (c CrawlOptions) Validate() error {
+	if c.CrawlerOptions != nil {
+		return c.CrawlerOptions.Validate()
+	}
+	return nil
+}
+
+// resolveParams normalizes params, which may be nil, a map[string]any (the
+// original, backward-compatible shape), or a typed option struct
+// implementing paramsConverter (CrawlerOptions, PageOptions, ScrapeOptions,
+// CrawlOptions), into a map[string]any ready to be merged into a request
+// body. Typed option structs implementing validator are validated first.
+func resolveParams(params any) (map[string]any, error) {
+	// A nil *ScrapeOptions/*CrawlOptions/etc. still satisfies paramsConverter
+	// (ToMap has a value receiver, so the method set of the pointer type
+	// includes it), which would otherwise reach p.ToMap() below and panic
+	// dereferencing a nil pointer. Treat it the same as untyped nil.
+	if v := reflect.ValueOf(params); v.Kind() == reflect.Pointer && v.IsNil() {
+		return nil, nil
+	}
+
+	switch p := params.(type) {
+	case nil:
+		return nil, nil
+	case map[string]any:
+		return p, nil
+	case paramsConverter:
+		if v, ok := params.(validator); ok {
+			if err := v.Validate(); err != nil {
+				return nil, err
+			}
+		}
+		return p.ToMap(), nil
+	default:
+		return nil, fmt.Errorf("unsupported params type %T", params)
+	}
+}
@@ -0,0 +1,76 @@
+package firecrawl
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCrawlerOptionsToMap(t *testing.T) {
+	opts := CrawlerOptions{Excludes: []string{"blog/*"}, MaxDepth: 2}
+	m := opts.ToMap()
+	assert.Equal(t, []string{"blog/*"}, m["excludes"])
+	assert.Equal(t, 2, m["maxDepth"])
+	assert.NotContains(t, m, "includes")
+}
+
+func TestCrawlerOptionsValidateRejectsNegativeMaxDepth(t *testing.T) {
+	opts := CrawlerOptions{MaxDepth: -1}
+	assert.Error(t, opts.Validate())
+}
+
+func TestPageOptionsToMap(t *testing.T) {
+	opts := PageOptions{OnlyMainContent: true, Proxy: "127.0.0.1:9050"}
+	m := opts.ToMap()
+	assert.Equal(t, true, m["onlyMainContent"])
+	assert.Equal(t, "127.0.0.1:9050", m["proxy"])
+}
+
+func TestResolveParamsAcceptsMapAndTypedOptions(t *testing.T) {
+	m, err := resolveParams(map[string]any{"foo": "bar"})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]any{"foo": "bar"}, m)
+
+	m, err = resolveParams(nil)
+	require.NoError(t, err)
+	assert.Nil(t, m)
+
+	m, err = resolveParams(ScrapeOptions{PageOptions: &PageOptions{OnlyMainContent: true}})
+	require.NoError(t, err)
+	assert.Contains(t, m, "pageOptions")
+}
+
+func TestResolveParamsValidatesTypedOptions(t *testing.T) {
+	_, err := resolveParams(CrawlOptions{CrawlerOptions: &CrawlerOptions{MaxDepth: -5}})
+	assert.Error(t, err)
+}
+
+func TestResolveParamsRejectsUnsupportedType(t *testing.T) {
+	_, err := resolveParams(42)
+	assert.Error(t, err)
+}
+
+func TestResolveParamsTreatsNilTypedPointerAsNil(t *testing.T) {
+	var so *ScrapeOptions
+	m, err := resolveParams(so)
+	require.NoError(t, err)
+	assert.Nil(t, m)
+
+	var co *CrawlOptions
+	m, err = resolveParams(co)
+	require.NoError(t, err)
+	assert.Nil(t, m)
+}
+
+func TestScrapeURLAcceptsTypedScrapeOptions(t *testing.T) {
+	app, err := NewFirecrawlApp(TEST_API_KEY, API_URL)
+	require.NoError(t, err)
+
+	response, err := app.ScrapeURL("https://roastmywebsite.ai", ScrapeOptions{
+		PageOptions: &PageOptions{IncludeHTML: true},
+	})
+	require.NoError(t, err)
+	assert.NotNil(t, response)
+	assert.Contains(t, response.HTML, "<h1")
+}
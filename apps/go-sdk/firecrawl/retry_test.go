@@ -0,0 +1,93 @@
+package firecrawl
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMakeRequestRetriesOnRetryableStatusThenSucceeds(t *testing.T) {
+	var attempts int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&attempts, 1) < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			w.Write([]byte(`{"error": "try again"}`))
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"success": true}`))
+	}))
+	defer server.Close()
+
+	app, err := NewFirecrawlAppWithOptions("test-key", server.URL, ClientOptions{
+		RetryPolicy: RetryPolicy{MaxAttempts: 3, InitialBackoff: time.Millisecond, MaxBackoff: 5 * time.Millisecond},
+	})
+	require.NoError(t, err)
+
+	body, err := app.makeRequest(context.Background(), http.MethodGet, server.URL, nil, nil, "test request")
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"success": true}`, string(body))
+	assert.Equal(t, int32(3), atomic.LoadInt32(&attempts))
+}
+
+func TestMakeRequestHonorsRetryAfterHeader(t *testing.T) {
+	var attempts int32
+	var firstAttemptAt, secondAttemptAt time.Time
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&attempts, 1) == 1 {
+			firstAttemptAt = time.Now()
+			w.Header().Set("Retry-After", "1")
+			w.WriteHeader(http.StatusTooManyRequests)
+			w.Write([]byte(`{"error": "slow down"}`))
+			return
+		}
+		secondAttemptAt = time.Now()
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"success": true}`))
+	}))
+	defer server.Close()
+
+	app, err := NewFirecrawlAppWithOptions("test-key", server.URL, ClientOptions{
+		RetryPolicy: RetryPolicy{MaxAttempts: 2, InitialBackoff: time.Millisecond, MaxBackoff: time.Millisecond},
+	})
+	require.NoError(t, err)
+
+	_, err = app.makeRequest(context.Background(), http.MethodGet, server.URL, nil, nil, "test request")
+	require.NoError(t, err)
+	assert.GreaterOrEqual(t, secondAttemptAt.Sub(firstAttemptAt), 900*time.Millisecond)
+}
+
+func TestMakeRequestStopsRetryingWhenContextCancelled(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+		w.Write([]byte(`{"error": "try again"}`))
+	}))
+	defer server.Close()
+
+	app, err := NewFirecrawlAppWithOptions("test-key", server.URL, ClientOptions{
+		RetryPolicy: RetryPolicy{MaxAttempts: 5, InitialBackoff: 50 * time.Millisecond, MaxBackoff: 50 * time.Millisecond},
+	})
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() {
+		time.Sleep(10 * time.Millisecond)
+		cancel()
+	}()
+
+	_, err = app.makeRequest(ctx, http.MethodGet, server.URL, nil, nil, "test request")
+	assert.ErrorIs(t, err, context.Canceled)
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	assert.Equal(t, 3*time.Second, parseRetryAfter("3"))
+	assert.Equal(t, time.Duration(0), parseRetryAfter(""))
+	assert.Equal(t, time.Duration(0), parseRetryAfter("not-a-number"))
+	assert.Equal(t, time.Duration(0), parseRetryAfter("-1"))
+}
@@ -0,0 +1,208 @@
+package firecrawl
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+	"sync"
+
+	"github.com/PuerkitoBio/goquery"
+	"github.com/andybalholm/cascadia"
+	"gopkg.in/yaml.v2"
+)
+
+// ScraperFunc mines structured findings out of a scraped document. It is
+// registered under a name via RegisterScraper and run locally (no network
+// or LLM calls) against every document ScrapeURL returns when that name is
+// listed in the "Scrapers" option.
+type ScraperFunc func(doc *FirecrawlDocument) (map[string][]string, error)
+
+var (
+	scrapersMu sync.RWMutex
+	scrapers   = map[string]ScraperFunc{
+		"emails": scrapeEmails,
+		"links":  scrapeLinks,
+	}
+)
+
+// RegisterScraper registers fn under name so it can be referenced from the
+// "Scrapers" option passed to ScrapeURL, or from a rule pack loaded with
+// LoadScraperConfig. Registering under an existing name replaces it.
+func RegisterScraper(name string, fn ScraperFunc) {
+	scrapersMu.Lock()
+	defer scrapersMu.Unlock()
+	scrapers[name] = fn
+}
+
+// lookupScraper resolves a scraper rule name to a ScraperFunc. Names of the
+// form "regex:<pattern>", "meta:<key>", and "css:<selector>" are handled
+// generically without requiring prior registration, as is the specific
+// rule "meta:jsonld"; "xpath:<expr>" is rejected explicitly, since this
+// package has no XPath engine (use a "css:" selector instead). Everything
+// else must have been registered via RegisterScraper (built-ins "emails"
+// and "links" are registered by default).
+func lookupScraper(rule string) (ScraperFunc, error) {
+	switch {
+	case rule == "all":
+		return scrapeAll, nil
+	case rule == "meta:jsonld":
+		return scrapeJSONLD, nil
+	case strings.HasPrefix(rule, "regex:"):
+		pattern := strings.TrimPrefix(rule, "regex:")
+		re, err := regexp.Compile(pattern)
+		if err != nil {
+			return nil, fmt.Errorf("scraper %q: invalid regex: %w", rule, err)
+		}
+		return func(doc *FirecrawlDocument) (map[string][]string, error) {
+			return map[string][]string{rule: re.FindAllString(doc.Content, -1)}, nil
+		}, nil
+	case strings.HasPrefix(rule, "meta:"):
+		key := strings.TrimPrefix(rule, "meta:")
+		return func(doc *FirecrawlDocument) (map[string][]string, error) {
+			if doc.Metadata == nil || doc.Metadata.Meta == nil {
+				return nil, nil
+			}
+			if value, ok := doc.Metadata.Meta[key]; ok {
+				return map[string][]string{rule: {value}}, nil
+			}
+			return nil, nil
+		}, nil
+	case strings.HasPrefix(rule, "css:"):
+		selector := strings.TrimPrefix(rule, "css:")
+		if _, err := cascadia.Compile(selector); err != nil {
+			return nil, fmt.Errorf("scraper %q: invalid CSS selector: %w", rule, err)
+		}
+		return func(doc *FirecrawlDocument) (map[string][]string, error) {
+			parsed, err := goquery.NewDocumentFromReader(strings.NewReader(doc.HTML))
+			if err != nil {
+				return nil, fmt.Errorf("scraper %q: failed to parse HTML: %w", rule, err)
+			}
+			var values []string
+			parsed.Find(selector).Each(func(_ int, s *goquery.Selection) {
+				values = append(values, strings.TrimSpace(s.Text()))
+			})
+			return map[string][]string{rule: values}, nil
+		}, nil
+	case strings.HasPrefix(rule, "xpath:"):
+		return nil, fmt.Errorf("scraper %q: xpath rules are not supported, use a \"css:\" rule instead", rule)
+	}
+
+	scrapersMu.RLock()
+	fn, ok := scrapers[rule]
+	scrapersMu.RUnlock()
+	if !ok {
+		return nil, fmt.Errorf("unknown scraper rule %q", rule)
+	}
+	return fn, nil
+}
+
+// runScrapers runs every rule in rules against doc and merges their findings
+// into a single map[string][]string, attached by ScrapeURL to doc.Scraped.
+func runScrapers(doc *FirecrawlDocument, rules []string) (map[string][]string, error) {
+	findings := map[string][]string{}
+	for _, rule := range rules {
+		fn, err := lookupScraper(rule)
+		if err != nil {
+			return nil, err
+		}
+		ruleFindings, err := fn(doc)
+		if err != nil {
+			return nil, fmt.Errorf("scraper %q: %w", rule, err)
+		}
+		for key, values := range ruleFindings {
+			findings[key] = append(findings[key], values...)
+		}
+	}
+	return findings, nil
+}
+
+var emailPattern = regexp.MustCompile(`[a-zA-Z0-9._%+\-]+@[a-zA-Z0-9.\-]+\.[a-zA-Z]{2,}`)
+
+// scrapeEmails extracts email addresses found in the document's Content.
+func scrapeEmails(doc *FirecrawlDocument) (map[string][]string, error) {
+	return map[string][]string{"emails": emailPattern.FindAllString(doc.Content, -1)}, nil
+}
+
+// scrapeLinks extracts the document's child links.
+func scrapeLinks(doc *FirecrawlDocument) (map[string][]string, error) {
+	return map[string][]string{"links": doc.ChildrenLinks}, nil
+}
+
+// scrapeJSONLD extracts the raw contents of every
+// <script type="application/ld+json"> block on the page. Unlike "meta:<key>",
+// which only ever sees <meta name=... content=...> tags, JSON-LD lives in a
+// script body, so it needs its own rule rather than falling through to the
+// generic meta handler.
+func scrapeJSONLD(doc *FirecrawlDocument) (map[string][]string, error) {
+	parsed, err := goquery.NewDocumentFromReader(strings.NewReader(doc.HTML))
+	if err != nil {
+		return nil, fmt.Errorf("scraper %q: failed to parse HTML: %w", "meta:jsonld", err)
+	}
+	var values []string
+	parsed.Find(`script[type="application/ld+json"]`).Each(func(_ int, s *goquery.Selection) {
+		if text := strings.TrimSpace(s.Text()); text != "" {
+			values = append(values, text)
+		}
+	})
+	return map[string][]string{"meta:jsonld": values}, nil
+}
+
+// scrapeAll runs every registered scraper.
+func scrapeAll(doc *FirecrawlDocument) (map[string][]string, error) {
+	findings := map[string][]string{}
+	scrapersMu.RLock()
+	names := make([]string, 0, len(scrapers))
+	for name := range scrapers {
+		names = append(names, name)
+	}
+	scrapersMu.RUnlock()
+
+	for _, name := range names {
+		fn, err := lookupScraper(name)
+		if err != nil {
+			return nil, err
+		}
+		ruleFindings, err := fn(doc)
+		if err != nil {
+			return nil, err
+		}
+		for key, values := range ruleFindings {
+			findings[key] = append(findings[key], values...)
+		}
+	}
+	return findings, nil
+}
+
+// ScraperConfig is a rule pack: a flat list of scraper rule names (the same
+// strings accepted by the "Scrapers" ScrapeURL option), loadable from YAML
+// or JSON so rule packs can be shipped and shared as files.
+type ScraperConfig struct {
+	Scrapers []string `json:"scrapers" yaml:"scrapers"`
+}
+
+// LoadScraperConfig parses a YAML or JSON rule pack (detected by content:
+// JSON documents start with '{') into a ScraperConfig.
+//
+// Parameters:
+//   - data: The raw file contents of the rule pack.
+//
+// Returns:
+//   - ScraperConfig: The parsed rule pack.
+//   - error: An error if data is neither valid YAML nor valid JSON.
+func LoadScraperConfig(data []byte) (ScraperConfig, error) {
+	var cfg ScraperConfig
+
+	trimmed := strings.TrimSpace(string(data))
+	if strings.HasPrefix(trimmed, "{") {
+		if err := json.Unmarshal(data, &cfg); err != nil {
+			return ScraperConfig{}, fmt.Errorf("failed to parse scraper config as JSON: %w", err)
+		}
+		return cfg, nil
+	}
+
+	if err := yaml.Unmarshal(data, &cfg); err != nil {
+		return ScraperConfig{}, fmt.Errorf("failed to parse scraper config as YAML: %w", err)
+	}
+	return cfg, nil
+}
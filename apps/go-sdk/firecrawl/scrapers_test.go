@@ -0,0 +1,105 @@
+package firecrawl
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestScrapeEmails(t *testing.T) {
+	doc := &FirecrawlDocument{Content: "contact us at hello@firecrawl.dev or support@example.com"}
+	findings, err := scrapeEmails(doc)
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{"hello@firecrawl.dev", "support@example.com"}, findings["emails"])
+}
+
+func TestLookupScraperRegex(t *testing.T) {
+	fn, err := lookupScraper(`regex:\d{3}-\d{4}`)
+	require.NoError(t, err)
+
+	doc := &FirecrawlDocument{Content: "call 555-1234 now"}
+	findings, err := fn(doc)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"555-1234"}, findings[`regex:\d{3}-\d{4}`])
+}
+
+func TestLookupScraperMeta(t *testing.T) {
+	fn, err := lookupScraper("meta:og:type")
+	require.NoError(t, err)
+
+	doc := &FirecrawlDocument{Metadata: &FirecrawlDocumentMetadata{Meta: map[string]string{"og:type": "article"}}}
+	findings, err := fn(doc)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"article"}, findings["meta:og:type"])
+}
+
+func TestLookupScraperMetaJSONLD(t *testing.T) {
+	fn, err := lookupScraper("meta:jsonld")
+	require.NoError(t, err)
+
+	doc := &FirecrawlDocument{HTML: `<html><head>
+		<script type="application/ld+json">{"@type": "Article", "headline": "Hello"}</script>
+	</head><body></body></html>`}
+	findings, err := fn(doc)
+	require.NoError(t, err)
+	assert.Equal(t, []string{`{"@type": "Article", "headline": "Hello"}`}, findings["meta:jsonld"])
+}
+
+func TestLookupScraperMetaJSONLDNoScriptTags(t *testing.T) {
+	fn, err := lookupScraper("meta:jsonld")
+	require.NoError(t, err)
+
+	doc := &FirecrawlDocument{HTML: "<html><body><p>no ld+json here</p></body></html>"}
+	findings, err := fn(doc)
+	require.NoError(t, err)
+	assert.Empty(t, findings["meta:jsonld"])
+}
+
+func TestLookupScraperUnknownRule(t *testing.T) {
+	_, err := lookupScraper("nonsense")
+	assert.Error(t, err)
+}
+
+func TestLookupScraperCSS(t *testing.T) {
+	fn, err := lookupScraper("css:h1")
+	require.NoError(t, err)
+
+	doc := &FirecrawlDocument{HTML: "<html><body><h1>Title</h1><p>body</p></body></html>"}
+	findings, err := fn(doc)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"Title"}, findings["css:h1"])
+}
+
+func TestLookupScraperCSSInvalidSelector(t *testing.T) {
+	_, err := lookupScraper("css:[")
+	assert.Error(t, err)
+}
+
+func TestLookupScraperXPathUnsupported(t *testing.T) {
+	_, err := lookupScraper("xpath://h1")
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "xpath rules are not supported")
+}
+
+func TestRegisterScraperAndRunScrapers(t *testing.T) {
+	RegisterScraper("test:constant", func(doc *FirecrawlDocument) (map[string][]string, error) {
+		return map[string][]string{"constant": {"value"}}, nil
+	})
+
+	doc := &FirecrawlDocument{Content: "reach me at a@b.com"}
+	findings, err := runScrapers(doc, []string{"emails", "test:constant"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"a@b.com"}, findings["emails"])
+	assert.Equal(t, []string{"value"}, findings["constant"])
+}
+
+func TestLoadScraperConfigJSONAndYAML(t *testing.T) {
+	jsonCfg, err := LoadScraperConfig([]byte(`{"scrapers": ["emails", "links"]}`))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"emails", "links"}, jsonCfg.Scrapers)
+
+	yamlCfg, err := LoadScraperConfig([]byte("scrapers:\n  - emails\n  - links\n"))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"emails", "links"}, yamlCfg.Scrapers)
+}
@@ -0,0 +1,105 @@
+package firecrawl
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"sync"
+)
+
+// Sink persists crawled documents as they arrive, instead of requiring
+// callers to accumulate them in memory. It is accepted by CrawlURLToSink and
+// SubscribeCrawlToSink.
+type Sink interface {
+	// Write persists doc. It is called once per document, in delivery order.
+	Write(ctx context.Context, doc *FirecrawlDocument) error
+	// Close releases any resources held by the Sink (open files, DB handles, ...).
+	Close() error
+}
+
+// jsonlSink writes one JSON-encoded document per line to a file.
+type jsonlSink struct {
+	mu   sync.Mutex
+	file *os.File
+	enc  *json.Encoder
+}
+
+// JSONLSink returns a Sink that appends each document as a JSON line to the
+// file at path, creating it if it doesn't already exist.
+func JSONLSink(path string) (Sink, error) {
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o644)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open JSONL sink file: %w", err)
+	}
+	return &jsonlSink{file: file, enc: json.NewEncoder(file)}, nil
+}
+
+// Write implements Sink.
+func (s *jsonlSink) Write(ctx context.Context, doc *FirecrawlDocument) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.enc.Encode(doc)
+}
+
+// Close implements Sink.
+func (s *jsonlSink) Close() error {
+	return s.file.Close()
+}
+
+// dirSink writes each document as its own JSON file under root.
+type dirSink struct {
+	mu   sync.Mutex
+	root string
+}
+
+// DirSink returns a Sink that writes each document to its own JSON file
+// under root (created if missing), named after a filesystem-safe slug of
+// the document's URL with a ".json" suffix. Two documents that slugify to
+// the same name overwrite one another.
+func DirSink(root string) (Sink, error) {
+	if err := os.MkdirAll(root, 0o755); err != nil {
+		return nil, fmt.Errorf("failed to create DirSink root: %w", err)
+	}
+	return &dirSink{root: root}, nil
+}
+
+// Write implements Sink.
+func (s *dirSink) Write(ctx context.Context, doc *FirecrawlDocument) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	data, err := json.MarshalIndent(doc, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	path := filepath.Join(s.root, slugifyURL(doc.URL)+".json")
+	return os.WriteFile(path, data, 0o644)
+}
+
+// Close implements Sink.
+func (s *dirSink) Close() error {
+	return nil
+}
+
+var slugPattern = regexp.MustCompile(`[^a-zA-Z0-9.-]+`)
+
+// slugifyURL turns a URL into a filesystem- and object-key-safe slug, used
+// to name files/objects for DirSink and S3Sink.
+func slugifyURL(url string) string {
+	slug := strings.Trim(slugPattern.ReplaceAllString(url, "-"), "-")
+	if slug == "" {
+		slug = "document"
+	}
+	return slug
+}
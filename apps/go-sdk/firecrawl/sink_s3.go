@@ -0,0 +1,52 @@
+package firecrawl
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+)
+
+// s3Sink uploads each document as a JSON object to an S3 bucket.
+type s3Sink struct {
+	client *s3.Client
+	bucket string
+	prefix string
+}
+
+// S3Sink returns a Sink that uploads each document as a JSON object to
+// bucket under prefix, keyed by a slugified form of the document's URL.
+// Credentials and region are resolved via the default AWS config chain
+// (environment, shared config, instance role, ...).
+func S3Sink(ctx context.Context, bucket, prefix string) (Sink, error) {
+	cfg, err := config.LoadDefaultConfig(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load AWS config for S3 sink: %w", err)
+	}
+	return &s3Sink{client: s3.NewFromConfig(cfg), bucket: bucket, prefix: prefix}, nil
+}
+
+// Write implements Sink.
+func (s *s3Sink) Write(ctx context.Context, doc *FirecrawlDocument) error {
+	data, err := json.Marshal(doc)
+	if err != nil {
+		return err
+	}
+
+	key := s.prefix + slugifyURL(doc.URL) + ".json"
+	_, err = s.client.PutObject(ctx, &s3.PutObjectInput{
+		Bucket: aws.String(s.bucket),
+		Key:    aws.String(key),
+		Body:   bytes.NewReader(data),
+	})
+	return err
+}
+
+// Close implements Sink. S3Sink holds no resources that need releasing.
+func (s *s3Sink) Close() error {
+	return nil
+}
@@ -0,0 +1,81 @@
+package firecrawl
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	_ "github.com/mattn/go-sqlite3"
+)
+
+// sqliteSink persists documents into a SQLite database.
+type sqliteSink struct {
+	mu sync.Mutex
+	db *sql.DB
+}
+
+const sqliteSinkSchema = `
+CREATE TABLE IF NOT EXISTS documents (
+	url        TEXT PRIMARY KEY,
+	status     INTEGER,
+	markdown   TEXT,
+	html       TEXT,
+	metadata   TEXT,
+	fetched_at TIMESTAMP
+);`
+
+// SQLiteSink returns a Sink that upserts each document, keyed by URL, into a
+// "documents" table (url, status, markdown, html, metadata JSON, fetched_at)
+// in the SQLite database at dsn, creating the table if it doesn't already exist.
+func SQLiteSink(dsn string) (Sink, error) {
+	db, err := sql.Open("sqlite3", dsn)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open SQLite sink: %w", err)
+	}
+	if _, err := db.Exec(sqliteSinkSchema); err != nil {
+		db.Close()
+		return nil, fmt.Errorf("failed to create documents table: %w", err)
+	}
+	return &sqliteSink{db: db}, nil
+}
+
+// Write implements Sink.
+func (s *sqliteSink) Write(ctx context.Context, doc *FirecrawlDocument) error {
+	var statusCode int
+	var metadata []byte
+	fetchedAt := time.Now()
+	if doc.Metadata != nil {
+		statusCode = doc.Metadata.PageStatusCode
+		if doc.Metadata.FetchedAt != nil {
+			fetchedAt = *doc.Metadata.FetchedAt
+		}
+		encoded, err := json.Marshal(doc.Metadata)
+		if err != nil {
+			return err
+		}
+		metadata = encoded
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	_, err := s.db.ExecContext(ctx, `
+		INSERT INTO documents (url, status, markdown, html, metadata, fetched_at)
+		VALUES (?, ?, ?, ?, ?, ?)
+		ON CONFLICT(url) DO UPDATE SET
+			status = excluded.status,
+			markdown = excluded.markdown,
+			html = excluded.html,
+			metadata = excluded.metadata,
+			fetched_at = excluded.fetched_at`,
+		doc.URL, statusCode, doc.Markdown, doc.HTML, string(metadata), fetchedAt,
+	)
+	return err
+}
+
+// Close implements Sink.
+func (s *sqliteSink) Close() error {
+	return s.db.Close()
+}
@@ -0,0 +1,92 @@
+package firecrawl
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestJSONLSinkAppendsOneDocumentPerLine(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "docs.jsonl")
+
+	sink, err := JSONLSink(path)
+	require.NoError(t, err)
+
+	require.NoError(t, sink.Write(context.Background(), &FirecrawlDocument{URL: "https://a.test"}))
+	require.NoError(t, sink.Write(context.Background(), &FirecrawlDocument{URL: "https://b.test"}))
+	require.NoError(t, sink.Close())
+
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+
+	var docs []FirecrawlDocument
+	dec := json.NewDecoder(bytes.NewReader(data))
+	for {
+		var doc FirecrawlDocument
+		if err := dec.Decode(&doc); err != nil {
+			break
+		}
+		docs = append(docs, doc)
+	}
+	require.Len(t, docs, 2)
+	assert.Equal(t, "https://a.test", docs[0].URL)
+	assert.Equal(t, "https://b.test", docs[1].URL)
+}
+
+func TestJSONLSinkRoundTripsScrapedFindings(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "docs.jsonl")
+
+	sink, err := JSONLSink(path)
+	require.NoError(t, err)
+
+	want := map[string][]string{"emails": {"a@test.com"}}
+	require.NoError(t, sink.Write(context.Background(), &FirecrawlDocument{URL: "https://a.test", Scraped: want}))
+	require.NoError(t, sink.Close())
+
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+
+	var doc FirecrawlDocument
+	require.NoError(t, json.Unmarshal(data, &doc))
+	assert.Equal(t, want, doc.Scraped)
+}
+
+func TestJSONLSinkRejectsWriteAfterContextCancelled(t *testing.T) {
+	sink, err := JSONLSink(filepath.Join(t.TempDir(), "docs.jsonl"))
+	require.NoError(t, err)
+	defer sink.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	assert.ErrorIs(t, sink.Write(ctx, &FirecrawlDocument{URL: "https://a.test"}), context.Canceled)
+}
+
+func TestDirSinkWritesOneFilePerURL(t *testing.T) {
+	root := t.TempDir()
+
+	sink, err := DirSink(root)
+	require.NoError(t, err)
+	defer sink.Close()
+
+	require.NoError(t, sink.Write(context.Background(), &FirecrawlDocument{URL: "https://example.com/a b", Markdown: "# A"}))
+
+	entries, err := os.ReadDir(root)
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+
+	data, err := os.ReadFile(filepath.Join(root, entries[0].Name()))
+	require.NoError(t, err)
+	assert.Contains(t, string(data), "# A")
+}
+
+func TestSlugifyURL(t *testing.T) {
+	assert.Equal(t, "https-example.com-a-b", slugifyURL("https://example.com/a b"))
+	assert.Equal(t, "document", slugifyURL(""))
+}
@@ -0,0 +1,69 @@
+package firecrawl
+
+import (
+	"context"
+	"fmt"
+)
+
+// CrawlURLStream starts a crawl job for the specified URL and invokes handler
+// once for each document as it becomes available, instead of blocking until
+// the entire crawl completes.
+//
+// It is a convenience wrapper around SubscribeCrawl for callers who want a
+// simple per-document callback instead of consuming a CrawlEvent channel
+// directly. If ctx is cancelled before the crawl completes, the crawl job is
+// cancelled via CancelCrawlJob and ctx.Err() is returned.
+//
+// Parameters:
+//   - ctx: Controls cancellation of the stream. When done, the underlying crawl job is cancelled.
+//   - url: The URL to crawl.
+//   - params: Optional parameters for the crawl request.
+//   - idempotencyKey: An optional idempotency key to ensure the request is idempotent.
+//   - handler: Called once for each newly-completed document. If it returns an error, streaming stops and that error is returned.
+//
+// Returns:
+//   - error: An error if starting the crawl, subscribing to its status, or handler fails; nil once the crawl completes successfully.
+func (app *FirecrawlApp) CrawlURLStream(ctx context.Context, url string, params map[string]any, idempotencyKey string, handler func(*FirecrawlDocument) error) error {
+	jobIDAny, err := app.CrawlURL(url, params, false, 0, idempotencyKey)
+	if err != nil {
+		return err
+	}
+	jobID, ok := jobIDAny.(string)
+	if !ok || jobID == "" {
+		return fmt.Errorf("failed to get job ID")
+	}
+
+	// A child context, not ctx itself: if handler returns an error we stop
+	// ranging over events before it reaches a terminal state, and SubscribeCrawl's
+	// producer goroutine only stops sending once its context is done.
+	subscribeCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	events, err := app.SubscribeCrawl(subscribeCtx, jobID, 0)
+	if err != nil {
+		_, _ = app.CancelCrawlJob(jobID)
+		return err
+	}
+
+	for event := range events {
+		switch event.Type {
+		case CrawlEventDocument:
+			if err := handler(event.Document); err != nil {
+				_, _ = app.CancelCrawlJob(jobID)
+				return err
+			}
+		case CrawlEventCompleted:
+			return nil
+		case CrawlEventFailed:
+			_, _ = app.CancelCrawlJob(jobID)
+			if event.Err != nil {
+				return event.Err
+			}
+			return fmt.Errorf("crawl job failed or was stopped")
+		}
+	}
+
+	// The channel closed without a terminal event; ctx must be done.
+	_, _ = app.CancelCrawlJob(jobID)
+	return ctx.Err()
+}
@@ -0,0 +1,113 @@
+package firecrawl
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"runtime"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newStuckCrawlServer starts a job that never reaches a terminal status,
+// always reporting the same partial document, so a handler/sink gets one
+// CrawlEventDocument on the first poll (later polls repeat an already-seen URL).
+func newStuckCrawlServer() *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/v0/crawl":
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(`{"success": true, "jobId": "job1"}`))
+		case r.Method == http.MethodGet && strings.HasPrefix(r.URL.Path, "/v0/crawl/status/"):
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(`{"success": true, "status": "active", "partial_data": [{"url": "https://a.test"}]}`))
+		case r.Method == http.MethodDelete && strings.HasPrefix(r.URL.Path, "/v0/crawl/cancel/"):
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(`{"success": true, "status": "cancelled"}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+}
+
+// assertGoroutineCountSettlesTo polls runtime.NumGoroutine() until it's back
+// at or below baseline, failing if it never gets there. Used to catch a
+// polling goroutine left running after its consumer has stopped.
+func assertGoroutineCountSettlesTo(t *testing.T, baseline int) {
+	t.Helper()
+	deadline := time.Now().Add(3 * time.Second)
+	for time.Now().Before(deadline) {
+		if runtime.NumGoroutine() <= baseline {
+			return
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	t.Errorf("goroutine count did not settle back to baseline %d, got %d", baseline, runtime.NumGoroutine())
+}
+
+func TestCrawlURLStreamStopsPollingGoroutineWhenHandlerErrors(t *testing.T) {
+	server := newStuckCrawlServer()
+	defer server.Close()
+
+	app, err := NewFirecrawlApp("test-key", server.URL)
+	require.NoError(t, err)
+	app.Client.Transport = &http.Transport{DisableKeepAlives: true}
+
+	runtime.Gosched()
+	baseline := runtime.NumGoroutine()
+
+	wantErr := errors.New("stop here")
+	err = app.CrawlURLStream(context.Background(), "https://a.test", nil, "", func(doc *FirecrawlDocument) error {
+		return wantErr
+	})
+	assert.ErrorIs(t, err, wantErr)
+
+	assertGoroutineCountSettlesTo(t, baseline)
+}
+
+func TestSubscribeCrawlToSinkStopsPollingGoroutineWhenSinkWriteFails(t *testing.T) {
+	server := newStuckCrawlServer()
+	defer server.Close()
+
+	app, err := NewFirecrawlApp("test-key", server.URL)
+	require.NoError(t, err)
+	app.Client.Transport = &http.Transport{DisableKeepAlives: true}
+
+	runtime.Gosched()
+	baseline := runtime.NumGoroutine()
+
+	wantErr := errors.New("sink write failed")
+	sink := &failingSink{err: wantErr}
+
+	events, err := app.SubscribeCrawlToSink(context.Background(), "job1", 0, sink)
+	require.NoError(t, err)
+
+	var sawFailed bool
+	for event := range events {
+		if event.Type == CrawlEventFailed {
+			assert.ErrorIs(t, event.Err, wantErr)
+			sawFailed = true
+		}
+	}
+	assert.True(t, sawFailed)
+
+	assertGoroutineCountSettlesTo(t, baseline)
+}
+
+// failingSink errors on every Write, for exercising mid-stream failure paths.
+type failingSink struct {
+	err error
+}
+
+func (s *failingSink) Write(ctx context.Context, doc *FirecrawlDocument) error {
+	return s.err
+}
+
+func (s *failingSink) Close() error {
+	return nil
+}
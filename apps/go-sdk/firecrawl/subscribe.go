@@ -0,0 +1,154 @@
+package firecrawl
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// CrawlEventType identifies the kind of update carried by a CrawlEvent.
+type CrawlEventType string
+
+const (
+	// CrawlEventDocument carries a single newly-available crawl result.
+	CrawlEventDocument CrawlEventType = "document"
+	// CrawlEventProgress reports a change in the crawl's current position.
+	CrawlEventProgress CrawlEventType = "progress"
+	// CrawlEventCompleted is the final event for a crawl that finished successfully.
+	CrawlEventCompleted CrawlEventType = "completed"
+	// CrawlEventFailed is the final event for a crawl that failed, was
+	// stopped, or whose subscription ended because ctx was done.
+	CrawlEventFailed CrawlEventType = "failed"
+)
+
+// CrawlEvent is a single update delivered by SubscribeCrawl.
+type CrawlEvent struct {
+	Type CrawlEventType
+	// Document is set when Type is CrawlEventDocument.
+	Document *FirecrawlDocument
+	// Current and Total report crawl progress; set when Type is
+	// CrawlEventProgress or CrawlEventCompleted.
+	Current int
+	Total   int
+	// Err is set when Type is CrawlEventFailed.
+	Err error
+}
+
+// SubscribeCrawl polls jobID's status at pollInterval and returns a channel
+// of CrawlEvent values: a CrawlEventDocument for each newly-available crawl
+// result, a CrawlEventProgress whenever Current or CurrentURL changes, and a
+// single terminal CrawlEventCompleted or CrawlEventFailed event before the
+// channel is closed.
+//
+// Unlike MonitorJobStatusContext, which blocks until the crawl completes,
+// SubscribeCrawl gives callers a push-style feed they can consume
+// incrementally (e.g. indexing documents as they arrive) and apply
+// backpressure to by not reading ahead.
+//
+// If ctx is done before the crawl reaches a terminal status, a
+// CrawlEventFailed event carrying ctx.Err() is emitted (best effort) and the
+// channel is closed; the crawl job itself is left running, so callers that
+// also want it stopped should call CancelCrawlJob.
+//
+// Parameters:
+//   - ctx: Controls cancellation of the subscription.
+//   - jobID: The ID of an already-started crawl job (see CrawlURL).
+//   - pollInterval: How often to poll the job status. A floor of 2 seconds is enforced.
+//
+// Returns:
+//   - <-chan CrawlEvent: The event stream; always closed exactly once, after its terminal event unless ctx is done first.
+//   - error: An error if ctx is already done.
+func (app *FirecrawlApp) SubscribeCrawl(ctx context.Context, jobID string, pollInterval time.Duration) (<-chan CrawlEvent, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	if pollInterval < 2*time.Second {
+		pollInterval = 2 * time.Second
+	}
+
+	events := make(chan CrawlEvent)
+
+	go func() {
+		defer close(events)
+
+		seen := make(map[string]bool)
+		lastCurrent := -1
+		lastCurrentURL := ""
+
+		ticker := time.NewTicker(pollInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				sendCrawlEvent(ctx, events, CrawlEvent{Type: CrawlEventFailed, Err: ctx.Err()})
+				return
+			case <-ticker.C:
+				status, err := app.CheckCrawlStatusContext(ctx, jobID)
+				if err != nil {
+					sendCrawlEvent(ctx, events, CrawlEvent{Type: CrawlEventFailed, Err: err})
+					return
+				}
+
+				if status.Current != lastCurrent || status.CurrentURL != lastCurrentURL {
+					lastCurrent, lastCurrentURL = status.Current, status.CurrentURL
+					if !sendCrawlEvent(ctx, events, CrawlEvent{Type: CrawlEventProgress, Current: status.Current, Total: status.Total}) {
+						return
+					}
+				}
+
+				if !emitNewCrawlDocuments(ctx, events, status.PartialData, seen) {
+					return
+				}
+
+				switch status.Status {
+				case "completed":
+					if !emitNewCrawlDocuments(ctx, events, status.Data, seen) {
+						return
+					}
+					sendCrawlEvent(ctx, events, CrawlEvent{Type: CrawlEventCompleted, Current: status.Current, Total: status.Total})
+					return
+				case "active", "paused", "pending", "queued", "waiting":
+					continue
+				default:
+					sendCrawlEvent(ctx, events, CrawlEvent{Type: CrawlEventFailed, Err: fmt.Errorf("crawl job failed or was stopped. Status: %s", status.Status)})
+					return
+				}
+			}
+		}
+	}()
+
+	return events, nil
+}
+
+// emitNewCrawlDocuments sends a CrawlEventDocument for each document in docs
+// not already present in seen (keyed by Metadata.SourceURL, falling back to
+// URL), recording it as seen once sent. It returns false if ctx was done
+// before every document could be sent.
+func emitNewCrawlDocuments(ctx context.Context, events chan<- CrawlEvent, docs []*FirecrawlDocument, seen map[string]bool) bool {
+	for _, doc := range docs {
+		key := doc.URL
+		if doc.Metadata != nil && doc.Metadata.SourceURL != "" {
+			key = doc.Metadata.SourceURL
+		}
+		if key == "" || seen[key] {
+			continue
+		}
+		seen[key] = true
+		if !sendCrawlEvent(ctx, events, CrawlEvent{Type: CrawlEventDocument, Document: doc}) {
+			return false
+		}
+	}
+	return true
+}
+
+// sendCrawlEvent sends event on events, returning false without blocking
+// forever if ctx is done first.
+func sendCrawlEvent(ctx context.Context, events chan<- CrawlEvent, event CrawlEvent) bool {
+	select {
+	case events <- event:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
@@ -0,0 +1,58 @@
+package firecrawl
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"golang.org/x/net/proxy"
+)
+
+// defaultClientTimeout is the request timeout applied to the http.Client
+// built by newHTTPClient, matching the previous hard-coded behavior.
+const defaultClientTimeout = 60 * time.Second
+
+// newHTTPClient builds the http.Client used by FirecrawlApp, honoring
+// ClientOptions.Transport and ClientOptions.ProxyURL so requests (including
+// to .onion hosts via a local Tor daemon) can be routed through a custom
+// transport or a SOCKS5 proxy.
+//
+// Parameters:
+//   - options: The client options the app was constructed with.
+//
+// Returns:
+//   - *http.Client: A client configured with the requested transport.
+//   - error: An error if ProxyURL is set but cannot be parsed into a dialer.
+func newHTTPClient(options ClientOptions) (*http.Client, error) {
+	client := &http.Client{Timeout: defaultClientTimeout}
+
+	switch {
+	case options.Transport != nil:
+		client.Transport = options.Transport
+	case options.ProxyURL != "":
+		transport, err := socks5Transport(options.ProxyURL)
+		if err != nil {
+			return nil, err
+		}
+		client.Transport = transport
+	}
+
+	return client, nil
+}
+
+// socks5Transport builds an http.RoundTripper that dials through the SOCKS5
+// proxy at proxyURL (host:port, e.g. "127.0.0.1:9050" for a local Tor
+// daemon), allowing .onion hosts to be scraped.
+func socks5Transport(proxyURL string) (http.RoundTripper, error) {
+	dialer, err := proxy.SOCKS5("tcp", proxyURL, nil, proxy.Direct)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create SOCKS5 dialer for %q: %w", proxyURL, err)
+	}
+
+	contextDialer, ok := dialer.(proxy.ContextDialer)
+	if !ok {
+		return nil, fmt.Errorf("SOCKS5 dialer for %q does not support context dialing", proxyURL)
+	}
+
+	return &http.Transport{DialContext: contextDialer.DialContext}, nil
+}
@@ -0,0 +1,101 @@
+package firecrawl
+
+import (
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// startMockSOCKS5Server starts a minimal SOCKS5 server (no-auth, CONNECT
+// only) that proxies every connection to target, and returns its listen
+// address. It is not a conformant SOCKS5 implementation, only enough to
+// exercise FirecrawlApp's proxy wiring end-to-end.
+func startMockSOCKS5Server(t *testing.T, target string) string {
+	t.Helper()
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() { listener.Close() })
+
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			go serveMockSOCKS5Conn(conn, target)
+		}
+	}()
+
+	return listener.Addr().String()
+}
+
+func serveMockSOCKS5Conn(conn net.Conn, target string) {
+	defer conn.Close()
+
+	// Greeting: version, nmethods, methods...
+	buf := make([]byte, 257)
+	if _, err := io.ReadAtLeast(conn, buf, 2); err != nil {
+		return
+	}
+	// No-auth required.
+	if _, err := conn.Write([]byte{0x05, 0x00}); err != nil {
+		return
+	}
+
+	// Request: version, cmd, rsv, atyp, addr, port. We don't bother parsing
+	// it; any target is proxied to the fixed backend.
+	if _, err := io.ReadAtLeast(conn, buf, 4); err != nil {
+		return
+	}
+	reply := []byte{0x05, 0x00, 0x00, 0x01, 0, 0, 0, 0, 0, 0}
+	if _, err := conn.Write(reply); err != nil {
+		return
+	}
+
+	upstream, err := net.Dial("tcp", target)
+	if err != nil {
+		return
+	}
+	defer upstream.Close()
+
+	done := make(chan struct{}, 2)
+	go func() { io.Copy(upstream, conn); done <- struct{}{} }()
+	go func() { io.Copy(conn, upstream); done <- struct{}{} }()
+	<-done
+}
+
+func TestNewHTTPClientRoutesThroughSOCKS5Proxy(t *testing.T) {
+	backend := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte("ok"))
+	}))
+	defer backend.Close()
+
+	backendAddr := backend.Listener.Addr().String()
+	proxyAddr := startMockSOCKS5Server(t, backendAddr)
+
+	client, err := newHTTPClient(ClientOptions{ProxyURL: proxyAddr})
+	require.NoError(t, err)
+	require.NotNil(t, client.Transport)
+
+	resp, err := client.Get(backend.URL)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	assert.Equal(t, "ok", string(body))
+}
+
+func TestNewHTTPClientTransportOverridesProxyURL(t *testing.T) {
+	custom := &http.Transport{}
+	client, err := newHTTPClient(ClientOptions{Transport: custom, ProxyURL: "127.0.0.1:9050"})
+	require.NoError(t, err)
+	assert.Same(t, http.RoundTripper(custom), client.Transport)
+}